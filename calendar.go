@@ -2,6 +2,8 @@ package calendar
 
 import (
 	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/lunisolar"
 )
 
 // baseTimezone 农历计算的标准时区, 为东经120°标准时
@@ -15,8 +17,7 @@ var sexagenaryDayBase time.Time
 
 func init() {
 	// 农历计算时区恒为北京时间(东经120°标准时)
-	// 这里没有通过loadLocation载入Asia/Shanghai, 而是用FixedZone来固定这个8小时的时差(尽管当前这两者当前是等同的)
-	baseTimezone = time.FixedZone("UTF+8", 8*60*60)
+	baseTimezone = lunisolar.BaseTimezone
 
 	// 1984年2月2日开始的农历年为甲子年
 	sexagenaryYearBase = time.Date(1984, 2, 2, 0, 0, 0, 0, baseTimezone)
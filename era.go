@@ -0,0 +1,1337 @@
+package calendar
+
+import "strings"
+
+// Era 年号
+// 年号的起止以农历日期表示, 年数一般按农历年(正月初一)递增, 与LunarDate的换算不涉及天文计算
+type Era struct {
+	name     [2]string
+	regime   *eraRegime
+	emperor  [2]string
+	start    LunarDate
+	end      LunarDate
+	firstNum int
+}
+
+// eraRecord 年号数据表中的一项, 字符串依次为简体与繁体
+type eraRecord struct {
+	// name 年号, 为空表示自此不用年号, 直至下一个年号启用
+	name    [2]string
+	emperor [2]string
+	// 启用该年号的农历年月, 月份为负数表示闰月, 如-4为闰四月
+	year, month int
+	// firstNum 启用时的年数, 一般为1(元年)
+	firstNum int
+}
+
+// eraRegime 政权及其依次使用的年号
+type eraRegime struct {
+	name [2]string
+	eras []eraRecord
+	// yearStarts 以建寅之外的月为岁首的时期
+	yearStarts []eraYearStart
+	// end 该政权最后一个年号停用的农历日期(不含)
+	end LunarDate
+}
+
+// eraYearStart 以建寅之外的月为岁首的时期, 其间岁首及之后的月计入下一年
+// 如汉初沿用秦制以十月为岁首, 汉武帝元封六年十月即为太初元年的第一个月
+type eraYearStart struct {
+	// from, to 该时期的起止(不含to)
+	from, to LunarDate
+	// month 岁首的月份, 按建寅计
+	month int
+}
+
+// yearOf 返回农历日期d在该政权下所属的年, 岁首不在正月时, 岁首及之后的月属于下一年
+func (r *eraRegime) yearOf(d LunarDate) int {
+	if r == nil {
+		return d.Year
+	}
+	for _, ys := range r.yearStarts {
+		if !lunarDateBefore(d, ys.from) && lunarDateBefore(d, ys.to) && d.Month >= ys.month {
+			return d.Year + 1
+		}
+	}
+	return d.Year
+}
+
+// eraRegimes 年号数据表
+// 收录两汉(西汉自建元起), 新, 玄汉, 三国, 两晋, 十六国, 南北朝, 隋, 唐, 武周, 五代十国, 辽, 宋, 金, 元, 明, 后金, 清与南明,
+// 年份采用天文纪年, 公元前140年记为-139. 年号以月为单位起止, 如泰昌元年始于万历四十八年八月; 建文年号在靖难后被废除, 建文四年七月起改称洪武三十五年.
+// 汉太初改历之前以十月为岁首, 新莽与魏景初年间以建丑(十二月)为岁首, 武周以建子(十一月)为岁首, 这些时期的年数按当时的岁首递增,
+// 但月份仍按建寅计, 如新莽的正月此处记为十二月. 唐肃宗上元二年九月至宝应元年四月间去年号, 唐在武周期间亦无年号纪年.
+// 南北朝部分: 南齐中兴元年三月至十二月间建康仍用永元年号, 此处只记中兴;
+// 同一政权内短暂并行或旋即废止的年号(如汉哀帝的太初元将, 晋赵王伦的建始, 桓玄的大亨与永始, 刘宋刘劭的太初, 梁萧栋的天正,
+// 北魏元朗的中兴与元修的永兴, 北齐高延宗的德昌, 宋苗刘兵变时的明受, 元天顺帝的天顺, 南明朱聿𨮁的绍武)未收录;
+// 西魏废帝, 恭帝与北周孝闵帝, 明帝即位初不建年号, 这些时期没有年号纪年.
+// 前凉, 吴越, 楚, 荆南等主要沿用中原年号的政权, 以及西秦与南凉降附后秦期间, 殷的天德, 北元, 明郑均未收录;
+// 十六国与十国的政权起止取其年号纪年的起止, 不在收录范围内的日期NewEraDates返回空
+var eraRegimes = []eraRegime{
+	{
+		name: [2]string{"西汉", "西漢"},
+		eras: []eraRecord{
+			{[2]string{"建元", "建元"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -140, 10, 1},
+			{[2]string{"元光", "元光"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -134, 10, 1},
+			{[2]string{"元朔", "元朔"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -128, 10, 1},
+			{[2]string{"元狩", "元狩"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -122, 10, 1},
+			{[2]string{"元鼎", "元鼎"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -116, 10, 1},
+			{[2]string{"元封", "元封"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -110, 10, 1},
+			{[2]string{"太初", "太初"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -104, 10, 1},
+			{[2]string{"天汉", "天漢"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -99, 1, 1},
+			{[2]string{"太始", "太始"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -95, 1, 1},
+			{[2]string{"征和", "征和"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -91, 1, 1},
+			{[2]string{"后元", "後元"}, [2]string{"汉武帝刘彻", "漢武帝劉徹"}, -87, 1, 1},
+			{[2]string{"始元", "始元"}, [2]string{"汉昭帝刘弗陵", "漢昭帝劉弗陵"}, -85, 1, 1},
+			{[2]string{"元凤", "元鳳"}, [2]string{"汉昭帝刘弗陵", "漢昭帝劉弗陵"}, -79, 1, 1},
+			{[2]string{"元平", "元平"}, [2]string{"汉昭帝刘弗陵", "漢昭帝劉弗陵"}, -73, 1, 1},
+			{[2]string{"本始", "本始"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -72, 1, 1},
+			{[2]string{"地节", "地節"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -68, 1, 1},
+			{[2]string{"元康", "元康"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -64, 1, 1},
+			{[2]string{"神爵", "神爵"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -60, 1, 1},
+			{[2]string{"五凤", "五鳳"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -56, 1, 1},
+			{[2]string{"甘露", "甘露"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -52, 1, 1},
+			{[2]string{"黄龙", "黃龍"}, [2]string{"汉宣帝刘询", "漢宣帝劉詢"}, -48, 1, 1},
+			{[2]string{"初元", "初元"}, [2]string{"汉元帝刘奭", "漢元帝劉奭"}, -47, 1, 1},
+			{[2]string{"永光", "永光"}, [2]string{"汉元帝刘奭", "漢元帝劉奭"}, -42, 1, 1},
+			{[2]string{"建昭", "建昭"}, [2]string{"汉元帝刘奭", "漢元帝劉奭"}, -37, 1, 1},
+			{[2]string{"竟宁", "竟寧"}, [2]string{"汉元帝刘奭", "漢元帝劉奭"}, -32, 1, 1},
+			{[2]string{"建始", "建始"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -31, 1, 1},
+			{[2]string{"河平", "河平"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -27, 1, 1},
+			{[2]string{"阳朔", "陽朔"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -23, 1, 1},
+			{[2]string{"鸿嘉", "鴻嘉"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -19, 1, 1},
+			{[2]string{"永始", "永始"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -15, 1, 1},
+			{[2]string{"元延", "元延"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -11, 1, 1},
+			{[2]string{"绥和", "綏和"}, [2]string{"汉成帝刘骜", "漢成帝劉驁"}, -7, 1, 1},
+			{[2]string{"建平", "建平"}, [2]string{"汉哀帝刘欣", "漢哀帝劉欣"}, -5, 1, 1},
+			{[2]string{"元寿", "元壽"}, [2]string{"汉哀帝刘欣", "漢哀帝劉欣"}, -1, 1, 1},
+			{[2]string{"元始", "元始"}, [2]string{"汉平帝刘衎", "漢平帝劉衎"}, 1, 1, 1},
+			{[2]string{"居摄", "居攝"}, [2]string{"孺子刘婴", "孺子劉嬰"}, 6, 1, 1},
+			{[2]string{"初始", "初始"}, [2]string{"孺子刘婴", "孺子劉嬰"}, 8, 11, 1},
+		},
+		yearStarts: []eraYearStart{
+			{LunarDate{Year: -140, Month: 10, Day: 1}, LunarDate{Year: -103, Month: 1, Day: 1}, 10},
+		},
+		end: LunarDate{Year: 8, Month: 12, Day: 1},
+	},
+	{
+		name: [2]string{"新", "新"},
+		eras: []eraRecord{
+			{[2]string{"始建国", "始建國"}, [2]string{"王莽", "王莽"}, 8, 12, 1},
+			{[2]string{"天凤", "天鳳"}, [2]string{"王莽", "王莽"}, 13, 12, 1},
+			{[2]string{"地皇", "地皇"}, [2]string{"王莽", "王莽"}, 19, 12, 1},
+		},
+		yearStarts: []eraYearStart{
+			{LunarDate{Year: 8, Month: 12, Day: 1}, LunarDate{Year: 23, Month: 10, Day: 1}, 12},
+		},
+		end: LunarDate{Year: 23, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"玄汉", "玄漢"},
+		eras: []eraRecord{
+			{[2]string{"更始", "更始"}, [2]string{"更始帝刘玄", "更始帝劉玄"}, 23, 2, 1},
+		},
+		end: LunarDate{Year: 25, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"东汉", "東漢"},
+		eras: []eraRecord{
+			{[2]string{"建武", "建武"}, [2]string{"汉光武帝刘秀", "漢光武帝劉秀"}, 25, 6, 1},
+			{[2]string{"建武中元", "建武中元"}, [2]string{"汉光武帝刘秀", "漢光武帝劉秀"}, 56, 4, 1},
+			{[2]string{"永平", "永平"}, [2]string{"汉明帝刘庄", "漢明帝劉莊"}, 58, 1, 1},
+			{[2]string{"建初", "建初"}, [2]string{"汉章帝刘炟", "漢章帝劉炟"}, 76, 1, 1},
+			{[2]string{"元和", "元和"}, [2]string{"汉章帝刘炟", "漢章帝劉炟"}, 84, 8, 1},
+			{[2]string{"章和", "章和"}, [2]string{"汉章帝刘炟", "漢章帝劉炟"}, 87, 7, 1},
+			{[2]string{"永元", "永元"}, [2]string{"汉和帝刘肇", "漢和帝劉肇"}, 89, 1, 1},
+			{[2]string{"元兴", "元興"}, [2]string{"汉和帝刘肇", "漢和帝劉肇"}, 105, 4, 1},
+			{[2]string{"延平", "延平"}, [2]string{"汉殇帝刘隆", "漢殤帝劉隆"}, 106, 1, 1},
+			{[2]string{"永初", "永初"}, [2]string{"汉安帝刘祜", "漢安帝劉祜"}, 107, 1, 1},
+			{[2]string{"元初", "元初"}, [2]string{"汉安帝刘祜", "漢安帝劉祜"}, 114, 1, 1},
+			{[2]string{"永宁", "永寧"}, [2]string{"汉安帝刘祜", "漢安帝劉祜"}, 120, 4, 1},
+			{[2]string{"建光", "建光"}, [2]string{"汉安帝刘祜", "漢安帝劉祜"}, 121, 7, 1},
+			{[2]string{"延光", "延光"}, [2]string{"汉安帝刘祜", "漢安帝劉祜"}, 122, 3, 1},
+			{[2]string{"永建", "永建"}, [2]string{"汉顺帝刘保", "漢順帝劉保"}, 126, 1, 1},
+			{[2]string{"阳嘉", "陽嘉"}, [2]string{"汉顺帝刘保", "漢順帝劉保"}, 132, 3, 1},
+			{[2]string{"永和", "永和"}, [2]string{"汉顺帝刘保", "漢順帝劉保"}, 136, 1, 1},
+			{[2]string{"汉安", "漢安"}, [2]string{"汉顺帝刘保", "漢順帝劉保"}, 142, 1, 1},
+			{[2]string{"建康", "建康"}, [2]string{"汉顺帝刘保", "漢順帝劉保"}, 144, 4, 1},
+			{[2]string{"永嘉", "永嘉"}, [2]string{"汉冲帝刘炳", "漢沖帝劉炳"}, 145, 1, 1},
+			{[2]string{"本初", "本初"}, [2]string{"汉质帝刘缵", "漢質帝劉纘"}, 146, 1, 1},
+			{[2]string{"建和", "建和"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 147, 1, 1},
+			{[2]string{"和平", "和平"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 150, 1, 1},
+			{[2]string{"元嘉", "元嘉"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 151, 1, 1},
+			{[2]string{"永兴", "永興"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 153, 5, 1},
+			{[2]string{"永寿", "永壽"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 155, 1, 1},
+			{[2]string{"延熹", "延熹"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 158, 6, 1},
+			{[2]string{"永康", "永康"}, [2]string{"汉桓帝刘志", "漢桓帝劉志"}, 167, 6, 1},
+			{[2]string{"建宁", "建寧"}, [2]string{"汉灵帝刘宏", "漢靈帝劉宏"}, 168, 1, 1},
+			{[2]string{"熹平", "熹平"}, [2]string{"汉灵帝刘宏", "漢靈帝劉宏"}, 172, 5, 1},
+			{[2]string{"光和", "光和"}, [2]string{"汉灵帝刘宏", "漢靈帝劉宏"}, 178, 3, 1},
+			{[2]string{"中平", "中平"}, [2]string{"汉灵帝刘宏", "漢靈帝劉宏"}, 184, 12, 1},
+			{[2]string{"光熹", "光熹"}, [2]string{"汉少帝刘辩", "漢少帝劉辯"}, 189, 4, 1},
+			{[2]string{"昭宁", "昭寧"}, [2]string{"汉少帝刘辩", "漢少帝劉辯"}, 189, 8, 1},
+			{[2]string{"永汉", "永漢"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 189, 9, 1},
+			{[2]string{"中平", "中平"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 189, 12, 6},
+			{[2]string{"初平", "初平"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 190, 1, 1},
+			{[2]string{"兴平", "興平"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 194, 1, 1},
+			{[2]string{"建安", "建安"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 196, 1, 1},
+			{[2]string{"延康", "延康"}, [2]string{"汉献帝刘协", "漢獻帝劉協"}, 220, 3, 1},
+		},
+		end: LunarDate{Year: 220, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"魏", "魏"},
+		eras: []eraRecord{
+			{[2]string{"黄初", "黃初"}, [2]string{"魏文帝曹丕", "魏文帝曹丕"}, 220, 10, 1},
+			{[2]string{"太和", "太和"}, [2]string{"魏明帝曹叡", "魏明帝曹叡"}, 227, 1, 1},
+			{[2]string{"青龙", "青龍"}, [2]string{"魏明帝曹叡", "魏明帝曹叡"}, 233, 2, 1},
+			{[2]string{"景初", "景初"}, [2]string{"魏明帝曹叡", "魏明帝曹叡"}, 237, 3, 1},
+			{[2]string{"正始", "正始"}, [2]string{"齐王曹芳", "齊王曹芳"}, 240, 1, 1},
+			{[2]string{"嘉平", "嘉平"}, [2]string{"齐王曹芳", "齊王曹芳"}, 249, 4, 1},
+			{[2]string{"正元", "正元"}, [2]string{"高贵乡公曹髦", "高貴鄉公曹髦"}, 254, 10, 1},
+			{[2]string{"甘露", "甘露"}, [2]string{"高贵乡公曹髦", "高貴鄉公曹髦"}, 256, 6, 1},
+			{[2]string{"景元", "景元"}, [2]string{"魏元帝曹奂", "魏元帝曹奐"}, 260, 6, 1},
+			{[2]string{"咸熙", "咸熙"}, [2]string{"魏元帝曹奂", "魏元帝曹奐"}, 264, 5, 1},
+		},
+		yearStarts: []eraYearStart{
+			{LunarDate{Year: 237, Month: 3, Day: 1}, LunarDate{Year: 239, Month: 12, Day: 1}, 12},
+		},
+		end: LunarDate{Year: 265, Month: 12, Day: 1},
+	},
+	{
+		name: [2]string{"蜀汉", "蜀漢"},
+		eras: []eraRecord{
+			{[2]string{"章武", "章武"}, [2]string{"汉昭烈帝刘备", "漢昭烈帝劉備"}, 221, 4, 1},
+			{[2]string{"建兴", "建興"}, [2]string{"汉后主刘禅", "漢後主劉禪"}, 223, 5, 1},
+			{[2]string{"延熙", "延熙"}, [2]string{"汉后主刘禅", "漢後主劉禪"}, 238, 1, 1},
+			{[2]string{"景耀", "景耀"}, [2]string{"汉后主刘禅", "漢後主劉禪"}, 258, 1, 1},
+			{[2]string{"炎兴", "炎興"}, [2]string{"汉后主刘禅", "漢後主劉禪"}, 263, 8, 1},
+		},
+		end: LunarDate{Year: 263, Month: 12, Day: 1},
+	},
+	{
+		name: [2]string{"吴", "吳"},
+		eras: []eraRecord{
+			{[2]string{"黄武", "黃武"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 222, 10, 1},
+			{[2]string{"黄龙", "黃龍"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 229, 4, 1},
+			{[2]string{"嘉禾", "嘉禾"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 232, 1, 1},
+			{[2]string{"赤乌", "赤烏"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 238, 8, 1},
+			{[2]string{"太元", "太元"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 251, 5, 1},
+			{[2]string{"神凤", "神鳳"}, [2]string{"吴大帝孙权", "吳大帝孫權"}, 252, 2, 1},
+			{[2]string{"建兴", "建興"}, [2]string{"会稽王孙亮", "會稽王孫亮"}, 252, 4, 1},
+			{[2]string{"五凤", "五鳳"}, [2]string{"会稽王孙亮", "會稽王孫亮"}, 254, 1, 1},
+			{[2]string{"太平", "太平"}, [2]string{"会稽王孙亮", "會稽王孫亮"}, 256, 10, 1},
+			{[2]string{"永安", "永安"}, [2]string{"吴景帝孙休", "吳景帝孫休"}, 258, 10, 1},
+			{[2]string{"元兴", "元興"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 264, 7, 1},
+			{[2]string{"甘露", "甘露"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 265, 4, 1},
+			{[2]string{"宝鼎", "寶鼎"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 266, 8, 1},
+			{[2]string{"建衡", "建衡"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 269, 10, 1},
+			{[2]string{"凤凰", "鳳凰"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 272, 1, 1},
+			{[2]string{"天册", "天冊"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 275, 1, 1},
+			{[2]string{"天玺", "天璽"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 276, 7, 1},
+			{[2]string{"天纪", "天紀"}, [2]string{"吴末帝孙皓", "吳末帝孫皓"}, 277, 1, 1},
+		},
+		end: LunarDate{Year: 280, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"西晋", "西晉"},
+		eras: []eraRecord{
+			{[2]string{"泰始", "泰始"}, [2]string{"晋武帝司马炎", "晉武帝司馬炎"}, 265, 12, 1},
+			{[2]string{"咸宁", "咸寧"}, [2]string{"晋武帝司马炎", "晉武帝司馬炎"}, 275, 1, 1},
+			{[2]string{"太康", "太康"}, [2]string{"晋武帝司马炎", "晉武帝司馬炎"}, 280, 4, 1},
+			{[2]string{"太熙", "太熙"}, [2]string{"晋武帝司马炎", "晉武帝司馬炎"}, 290, 1, 1},
+			{[2]string{"永熙", "永熙"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 290, 4, 1},
+			{[2]string{"永平", "永平"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 291, 1, 1},
+			{[2]string{"元康", "元康"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 291, 3, 1},
+			{[2]string{"永康", "永康"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 300, 1, 1},
+			{[2]string{"永宁", "永寧"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 301, 4, 1},
+			{[2]string{"太安", "太安"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 302, 12, 1},
+			{[2]string{"永安", "永安"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 304, 1, 1},
+			{[2]string{"建武", "建武"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 304, 7, 1},
+			{[2]string{"永安", "永安"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 304, 11, 1},
+			{[2]string{"永兴", "永興"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 304, 12, 1},
+			{[2]string{"光熙", "光熙"}, [2]string{"晋惠帝司马衷", "晉惠帝司馬衷"}, 306, 6, 1},
+			{[2]string{"永嘉", "永嘉"}, [2]string{"晋怀帝司马炽", "晉懷帝司馬熾"}, 307, 1, 1},
+			{[2]string{"建兴", "建興"}, [2]string{"晋愍帝司马邺", "晉愍帝司馬鄴"}, 313, 4, 1},
+		},
+		end: LunarDate{Year: 317, Month: 3, Day: 1},
+	},
+	{
+		name: [2]string{"汉赵", "漢趙"},
+		eras: []eraRecord{
+			{[2]string{"元熙", "元熙"}, [2]string{"汉光文帝刘渊", "漢光文帝劉淵"}, 304, 10, 1},
+			{[2]string{"永凤", "永鳳"}, [2]string{"汉光文帝刘渊", "漢光文帝劉淵"}, 308, 10, 1},
+			{[2]string{"河瑞", "河瑞"}, [2]string{"汉光文帝刘渊", "漢光文帝劉淵"}, 309, 1, 1},
+			{[2]string{"光兴", "光興"}, [2]string{"汉昭武帝刘聪", "漢昭武帝劉聰"}, 310, 7, 1},
+			{[2]string{"嘉平", "嘉平"}, [2]string{"汉昭武帝刘聪", "漢昭武帝劉聰"}, 311, 6, 1},
+			{[2]string{"建元", "建元"}, [2]string{"汉昭武帝刘聪", "漢昭武帝劉聰"}, 315, 3, 1},
+			{[2]string{"麟嘉", "麟嘉"}, [2]string{"汉昭武帝刘聪", "漢昭武帝劉聰"}, 316, 11, 1},
+			{[2]string{"汉昌", "漢昌"}, [2]string{"汉隐帝刘粲", "漢隱帝劉粲"}, 318, 7, 1},
+			{[2]string{"光初", "光初"}, [2]string{"前赵刘曜", "前趙劉曜"}, 318, 10, 1},
+		},
+		end: LunarDate{Year: 329, Month: 9, Day: 1},
+	},
+	{
+		name: [2]string{"成汉", "成漢"},
+		eras: []eraRecord{
+			{[2]string{"建兴", "建興"}, [2]string{"成武帝李雄", "成武帝李雄"}, 304, 10, 1},
+			{[2]string{"晏平", "晏平"}, [2]string{"成武帝李雄", "成武帝李雄"}, 306, 6, 1},
+			{[2]string{"玉衡", "玉衡"}, [2]string{"成武帝李雄", "成武帝李雄"}, 311, 1, 1},
+			{[2]string{"玉恒", "玉恒"}, [2]string{"成幽公李期", "成幽公李期"}, 335, 1, 1},
+			{[2]string{"汉兴", "漢興"}, [2]string{"汉昭文帝李寿", "漢昭文帝李壽"}, 338, 4, 1},
+			{[2]string{"太和", "太和"}, [2]string{"汉归义侯李势", "漢歸義侯李勢"}, 344, 1, 1},
+			{[2]string{"嘉宁", "嘉寧"}, [2]string{"汉归义侯李势", "漢歸義侯李勢"}, 346, 10, 1},
+		},
+		end: LunarDate{Year: 347, Month: 3, Day: 1},
+	},
+	{
+		name: [2]string{"东晋", "東晉"},
+		eras: []eraRecord{
+			{[2]string{"建武", "建武"}, [2]string{"晋元帝司马睿", "晉元帝司馬睿"}, 317, 3, 1},
+			{[2]string{"大兴", "大興"}, [2]string{"晋元帝司马睿", "晉元帝司馬睿"}, 318, 3, 1},
+			{[2]string{"永昌", "永昌"}, [2]string{"晋元帝司马睿", "晉元帝司馬睿"}, 322, 1, 1},
+			{[2]string{"太宁", "太寧"}, [2]string{"晋明帝司马绍", "晉明帝司馬紹"}, 323, 3, 1},
+			{[2]string{"咸和", "咸和"}, [2]string{"晋成帝司马衍", "晉成帝司馬衍"}, 326, 2, 1},
+			{[2]string{"咸康", "咸康"}, [2]string{"晋成帝司马衍", "晉成帝司馬衍"}, 335, 1, 1},
+			{[2]string{"建元", "建元"}, [2]string{"晋康帝司马岳", "晉康帝司馬岳"}, 343, 1, 1},
+			{[2]string{"永和", "永和"}, [2]string{"晋穆帝司马聃", "晉穆帝司馬聃"}, 345, 1, 1},
+			{[2]string{"升平", "升平"}, [2]string{"晋穆帝司马聃", "晉穆帝司馬聃"}, 357, 1, 1},
+			{[2]string{"隆和", "隆和"}, [2]string{"晋哀帝司马丕", "晉哀帝司馬丕"}, 362, 1, 1},
+			{[2]string{"兴宁", "興寧"}, [2]string{"晋哀帝司马丕", "晉哀帝司馬丕"}, 363, 2, 1},
+			{[2]string{"太和", "太和"}, [2]string{"晋废帝司马奕", "晉廢帝司馬奕"}, 366, 1, 1},
+			{[2]string{"咸安", "咸安"}, [2]string{"晋简文帝司马昱", "晉簡文帝司馬昱"}, 371, 11, 1},
+			{[2]string{"宁康", "寧康"}, [2]string{"晋孝武帝司马曜", "晉孝武帝司馬曜"}, 373, 1, 1},
+			{[2]string{"太元", "太元"}, [2]string{"晋孝武帝司马曜", "晉孝武帝司馬曜"}, 376, 1, 1},
+			{[2]string{"隆安", "隆安"}, [2]string{"晋安帝司马德宗", "晉安帝司馬德宗"}, 397, 1, 1},
+			{[2]string{"元兴", "元興"}, [2]string{"晋安帝司马德宗", "晉安帝司馬德宗"}, 402, 1, 1},
+			{[2]string{"义熙", "義熙"}, [2]string{"晋安帝司马德宗", "晉安帝司馬德宗"}, 405, 1, 1},
+			{[2]string{"元熙", "元熙"}, [2]string{"晋恭帝司马德文", "晉恭帝司馬德文"}, 419, 1, 1},
+		},
+		end: LunarDate{Year: 420, Month: 6, Day: 1},
+	},
+	{
+		name: [2]string{"后赵", "後趙"},
+		eras: []eraRecord{
+			{[2]string{"太和", "太和"}, [2]string{"后赵明帝石勒", "後趙明帝石勒"}, 328, 2, 1},
+			{[2]string{"建平", "建平"}, [2]string{"后赵明帝石勒", "後趙明帝石勒"}, 330, 9, 1},
+			{[2]string{"延熙", "延熙"}, [2]string{"后赵海阳王石弘", "後趙海陽王石弘"}, 334, 1, 1},
+			{[2]string{"建武", "建武"}, [2]string{"后赵武帝石虎", "後趙武帝石虎"}, 335, 1, 1},
+			{[2]string{"太宁", "太寧"}, [2]string{"后赵武帝石虎", "後趙武帝石虎"}, 349, 1, 1},
+			{[2]string{"青龙", "青龍"}, [2]string{"后赵义阳王石鉴", "後趙義陽王石鑒"}, 350, 1, 1},
+			{[2]string{"永宁", "永寧"}, [2]string{"后赵新兴王石祗", "後趙新興王石祗"}, 350, 3, 1},
+		},
+		end: LunarDate{Year: 351, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"冉魏", "冉魏"},
+		eras: []eraRecord{
+			{[2]string{"永兴", "永興"}, [2]string{"冉魏武悼天王冉闵", "冉魏武悼天王冉閔"}, 350, -2, 1},
+		},
+		end: LunarDate{Year: 352, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"前秦", "前秦"},
+		eras: []eraRecord{
+			{[2]string{"皇始", "皇始"}, [2]string{"前秦景明帝苻健", "前秦景明帝苻健"}, 351, 1, 1},
+			{[2]string{"寿光", "壽光"}, [2]string{"前秦厉王苻生", "前秦厲王苻生"}, 355, 6, 1},
+			{[2]string{"永兴", "永興"}, [2]string{"前秦宣昭帝苻坚", "前秦宣昭帝苻堅"}, 357, 6, 1},
+			{[2]string{"甘露", "甘露"}, [2]string{"前秦宣昭帝苻坚", "前秦宣昭帝苻堅"}, 359, 6, 1},
+			{[2]string{"建元", "建元"}, [2]string{"前秦宣昭帝苻坚", "前秦宣昭帝苻堅"}, 365, 1, 1},
+			{[2]string{"太安", "太安"}, [2]string{"前秦哀平帝苻丕", "前秦哀平帝苻丕"}, 385, 8, 1},
+			{[2]string{"太初", "太初"}, [2]string{"前秦高帝苻登", "前秦高帝苻登"}, 386, 11, 1},
+			{[2]string{"延初", "延初"}, [2]string{"前秦苻崇", "前秦苻崇"}, 394, 7, 1},
+		},
+		end: LunarDate{Year: 394, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"前燕", "前燕"},
+		eras: []eraRecord{
+			{[2]string{"元玺", "元璽"}, [2]string{"前燕景昭帝慕容儁", "前燕景昭帝慕容儁"}, 352, 11, 1},
+			{[2]string{"光寿", "光壽"}, [2]string{"前燕景昭帝慕容儁", "前燕景昭帝慕容儁"}, 357, 2, 1},
+			{[2]string{"建熙", "建熙"}, [2]string{"前燕幽帝慕容暐", "前燕幽帝慕容暐"}, 360, 1, 1},
+		},
+		end: LunarDate{Year: 370, Month: 11, Day: 1},
+	},
+	{
+		name: [2]string{"后燕", "後燕"},
+		eras: []eraRecord{
+			{[2]string{"燕元", "燕元"}, [2]string{"后燕成武帝慕容垂", "後燕成武帝慕容垂"}, 384, 1, 1},
+			{[2]string{"建兴", "建興"}, [2]string{"后燕成武帝慕容垂", "後燕成武帝慕容垂"}, 386, 2, 1},
+			{[2]string{"永康", "永康"}, [2]string{"后燕惠愍帝慕容宝", "後燕惠愍帝慕容寶"}, 396, 4, 1},
+			{[2]string{"建平", "建平"}, [2]string{"后燕昭武帝慕容盛", "後燕昭武帝慕容盛"}, 398, 10, 1},
+			{[2]string{"长乐", "長樂"}, [2]string{"后燕昭武帝慕容盛", "後燕昭武帝慕容盛"}, 399, 1, 1},
+			{[2]string{"光始", "光始"}, [2]string{"后燕昭文帝慕容熙", "後燕昭文帝慕容熙"}, 401, 8, 1},
+			{[2]string{"建始", "建始"}, [2]string{"后燕昭文帝慕容熙", "後燕昭文帝慕容熙"}, 407, 1, 1},
+		},
+		end: LunarDate{Year: 407, Month: 7, Day: 1},
+	},
+	{
+		name: [2]string{"后秦", "後秦"},
+		eras: []eraRecord{
+			{[2]string{"白雀", "白雀"}, [2]string{"后秦武昭帝姚苌", "後秦武昭帝姚萇"}, 384, 4, 1},
+			{[2]string{"建初", "建初"}, [2]string{"后秦武昭帝姚苌", "後秦武昭帝姚萇"}, 386, 4, 1},
+			{[2]string{"皇初", "皇初"}, [2]string{"后秦文桓帝姚兴", "後秦文桓帝姚興"}, 394, 5, 1},
+			{[2]string{"弘始", "弘始"}, [2]string{"后秦文桓帝姚兴", "後秦文桓帝姚興"}, 399, 9, 1},
+			{[2]string{"永和", "永和"}, [2]string{"后秦姚泓", "後秦姚泓"}, 416, 2, 1},
+		},
+		end: LunarDate{Year: 417, Month: 8, Day: 1},
+	},
+	{
+		name: [2]string{"西秦", "西秦"},
+		eras: []eraRecord{
+			{[2]string{"建义", "建義"}, [2]string{"西秦宣烈王乞伏国仁", "西秦宣烈王乞伏國仁"}, 385, 9, 1},
+			{[2]string{"太初", "太初"}, [2]string{"西秦武元王乞伏乾归", "西秦武元王乞伏乾歸"}, 388, 6, 1},
+			{[2]string{}, [2]string{}, 400, 7, 0},
+			{[2]string{"更始", "更始"}, [2]string{"西秦武元王乞伏乾归", "西秦武元王乞伏乾歸"}, 409, 7, 1},
+			{[2]string{"永康", "永康"}, [2]string{"西秦文昭王乞伏炽磐", "西秦文昭王乞伏熾磐"}, 412, 8, 1},
+			{[2]string{"建弘", "建弘"}, [2]string{"西秦文昭王乞伏炽磐", "西秦文昭王乞伏熾磐"}, 420, 1, 1},
+			{[2]string{"永弘", "永弘"}, [2]string{"西秦乞伏暮末", "西秦乞伏暮末"}, 428, 5, 1},
+		},
+		end: LunarDate{Year: 431, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"北魏", "北魏"},
+		eras: []eraRecord{
+			{[2]string{"登国", "登國"}, [2]string{"北魏道武帝拓跋珪", "北魏道武帝拓跋珪"}, 386, 1, 1},
+			{[2]string{"皇始", "皇始"}, [2]string{"北魏道武帝拓跋珪", "北魏道武帝拓跋珪"}, 396, 7, 1},
+			{[2]string{"天兴", "天興"}, [2]string{"北魏道武帝拓跋珪", "北魏道武帝拓跋珪"}, 398, 12, 1},
+			{[2]string{"天赐", "天賜"}, [2]string{"北魏道武帝拓跋珪", "北魏道武帝拓跋珪"}, 404, 10, 1},
+			{[2]string{"永兴", "永興"}, [2]string{"北魏明元帝拓跋嗣", "北魏明元帝拓跋嗣"}, 409, -10, 1},
+			{[2]string{"神瑞", "神瑞"}, [2]string{"北魏明元帝拓跋嗣", "北魏明元帝拓跋嗣"}, 414, 1, 1},
+			{[2]string{"泰常", "泰常"}, [2]string{"北魏明元帝拓跋嗣", "北魏明元帝拓跋嗣"}, 416, 4, 1},
+			{[2]string{"始光", "始光"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 424, 1, 1},
+			{[2]string{"神䴥", "神䴥"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 428, 2, 1},
+			{[2]string{"延和", "延和"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 432, 1, 1},
+			{[2]string{"太延", "太延"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 435, 1, 1},
+			{[2]string{"太平真君", "太平真君"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 440, 6, 1},
+			{[2]string{"正平", "正平"}, [2]string{"北魏太武帝拓跋焘", "北魏太武帝拓跋燾"}, 451, 6, 1},
+			{[2]string{"承平", "承平"}, [2]string{"南安王拓跋余", "南安王拓跋余"}, 452, 3, 1},
+			{[2]string{"兴安", "興安"}, [2]string{"北魏文成帝拓跋濬", "北魏文成帝拓跋濬"}, 452, 10, 1},
+			{[2]string{"兴光", "興光"}, [2]string{"北魏文成帝拓跋濬", "北魏文成帝拓跋濬"}, 454, 7, 1},
+			{[2]string{"太安", "太安"}, [2]string{"北魏文成帝拓跋濬", "北魏文成帝拓跋濬"}, 455, 6, 1},
+			{[2]string{"和平", "和平"}, [2]string{"北魏文成帝拓跋濬", "北魏文成帝拓跋濬"}, 460, 1, 1},
+			{[2]string{"天安", "天安"}, [2]string{"北魏献文帝拓跋弘", "北魏獻文帝拓跋弘"}, 466, 1, 1},
+			{[2]string{"皇兴", "皇興"}, [2]string{"北魏献文帝拓跋弘", "北魏獻文帝拓跋弘"}, 467, 8, 1},
+			{[2]string{"延兴", "延興"}, [2]string{"北魏孝文帝元宏", "北魏孝文帝元宏"}, 471, 8, 1},
+			{[2]string{"承明", "承明"}, [2]string{"北魏孝文帝元宏", "北魏孝文帝元宏"}, 476, 6, 1},
+			{[2]string{"太和", "太和"}, [2]string{"北魏孝文帝元宏", "北魏孝文帝元宏"}, 477, 1, 1},
+			{[2]string{"景明", "景明"}, [2]string{"北魏宣武帝元恪", "北魏宣武帝元恪"}, 500, 1, 1},
+			{[2]string{"正始", "正始"}, [2]string{"北魏宣武帝元恪", "北魏宣武帝元恪"}, 504, 1, 1},
+			{[2]string{"永平", "永平"}, [2]string{"北魏宣武帝元恪", "北魏宣武帝元恪"}, 508, 8, 1},
+			{[2]string{"延昌", "延昌"}, [2]string{"北魏宣武帝元恪", "北魏宣武帝元恪"}, 512, 4, 1},
+			{[2]string{"熙平", "熙平"}, [2]string{"北魏孝明帝元诩", "北魏孝明帝元詡"}, 516, 1, 1},
+			{[2]string{"神龟", "神龜"}, [2]string{"北魏孝明帝元诩", "北魏孝明帝元詡"}, 518, 2, 1},
+			{[2]string{"正光", "正光"}, [2]string{"北魏孝明帝元诩", "北魏孝明帝元詡"}, 520, 7, 1},
+			{[2]string{"孝昌", "孝昌"}, [2]string{"北魏孝明帝元诩", "北魏孝明帝元詡"}, 525, 6, 1},
+			{[2]string{"武泰", "武泰"}, [2]string{"北魏孝明帝元诩", "北魏孝明帝元詡"}, 528, 1, 1},
+			{[2]string{"建义", "建義"}, [2]string{"北魏孝庄帝元子攸", "北魏孝莊帝元子攸"}, 528, 4, 1},
+			{[2]string{"永安", "永安"}, [2]string{"北魏孝庄帝元子攸", "北魏孝莊帝元子攸"}, 528, 9, 1},
+			{[2]string{"建明", "建明"}, [2]string{"长广王元晔", "長廣王元曄"}, 530, 10, 1},
+			{[2]string{"普泰", "普泰"}, [2]string{"北魏节闵帝元恭", "北魏節閔帝元恭"}, 531, 2, 1},
+			{[2]string{"太昌", "太昌"}, [2]string{"北魏孝武帝元修", "北魏孝武帝元修"}, 532, 4, 1},
+			{[2]string{"永熙", "永熙"}, [2]string{"北魏孝武帝元修", "北魏孝武帝元修"}, 532, 12, 1},
+		},
+		end: LunarDate{Year: 535, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"后凉", "後涼"},
+		eras: []eraRecord{
+			{[2]string{"太安", "太安"}, [2]string{"后凉懿武帝吕光", "後涼懿武帝呂光"}, 386, 10, 1},
+			{[2]string{"麟嘉", "麟嘉"}, [2]string{"后凉懿武帝吕光", "後涼懿武帝呂光"}, 389, 2, 1},
+			{[2]string{"龙飞", "龍飛"}, [2]string{"后凉懿武帝吕光", "後涼懿武帝呂光"}, 396, 6, 1},
+			{[2]string{"咸宁", "咸寧"}, [2]string{"后凉灵帝吕纂", "後涼靈帝呂纂"}, 400, 1, 1},
+			{[2]string{"神鼎", "神鼎"}, [2]string{"后凉吕隆", "後涼呂隆"}, 401, 2, 1},
+		},
+		end: LunarDate{Year: 403, Month: 8, Day: 1},
+	},
+	{
+		name: [2]string{"南凉", "南涼"},
+		eras: []eraRecord{
+			{[2]string{"太初", "太初"}, [2]string{"南凉武王秃发乌孤", "南涼武王禿髮烏孤"}, 397, 1, 1},
+			{[2]string{"建和", "建和"}, [2]string{"南凉康王秃发利鹿孤", "南涼康王禿髮利鹿孤"}, 400, 1, 1},
+			{[2]string{"弘昌", "弘昌"}, [2]string{"南凉景王秃发傉檀", "南涼景王禿髮傉檀"}, 402, 3, 1},
+			{[2]string{}, [2]string{}, 404, 3, 0},
+			{[2]string{"嘉平", "嘉平"}, [2]string{"南凉景王秃发傉檀", "南涼景王禿髮傉檀"}, 408, 11, 1},
+		},
+		end: LunarDate{Year: 414, Month: 7, Day: 1},
+	},
+	{
+		name: [2]string{"北凉", "北涼"},
+		eras: []eraRecord{
+			{[2]string{"神玺", "神璽"}, [2]string{"北凉段业", "北涼段業"}, 397, 5, 1},
+			{[2]string{"天玺", "天璽"}, [2]string{"北凉段业", "北涼段業"}, 399, 2, 1},
+			{[2]string{"永安", "永安"}, [2]string{"北凉武宣王沮渠蒙逊", "北涼武宣王沮渠蒙遜"}, 401, 6, 1},
+			{[2]string{"玄始", "玄始"}, [2]string{"北凉武宣王沮渠蒙逊", "北涼武宣王沮渠蒙遜"}, 412, 11, 1},
+			{[2]string{"承玄", "承玄"}, [2]string{"北凉武宣王沮渠蒙逊", "北涼武宣王沮渠蒙遜"}, 428, 1, 1},
+			{[2]string{"义和", "義和"}, [2]string{"北凉武宣王沮渠蒙逊", "北涼武宣王沮渠蒙遜"}, 431, 6, 1},
+			{[2]string{"永和", "永和"}, [2]string{"北凉哀王沮渠牧犍", "北涼哀王沮渠牧犍"}, 433, 4, 1},
+		},
+		end: LunarDate{Year: 439, Month: 9, Day: 1},
+	},
+	{
+		name: [2]string{"南燕", "南燕"},
+		eras: []eraRecord{
+			{[2]string{"燕平", "燕平"}, [2]string{"南燕献武帝慕容德", "南燕獻武帝慕容德"}, 398, 1, 1},
+			{[2]string{"建平", "建平"}, [2]string{"南燕献武帝慕容德", "南燕獻武帝慕容德"}, 400, 1, 1},
+			{[2]string{"太上", "太上"}, [2]string{"南燕慕容超", "南燕慕容超"}, 405, 11, 1},
+		},
+		end: LunarDate{Year: 410, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"西凉", "西涼"},
+		eras: []eraRecord{
+			{[2]string{"庚子", "庚子"}, [2]string{"西凉武昭王李暠", "西涼武昭王李暠"}, 400, 11, 1},
+			{[2]string{"建初", "建初"}, [2]string{"西凉武昭王李暠", "西涼武昭王李暠"}, 405, 1, 1},
+			{[2]string{"嘉兴", "嘉興"}, [2]string{"西凉后主李歆", "西涼後主李歆"}, 417, 2, 1},
+		},
+		end: LunarDate{Year: 420, Month: 7, Day: 1},
+	},
+	{
+		name: [2]string{"胡夏", "胡夏"},
+		eras: []eraRecord{
+			{[2]string{"龙升", "龍昇"}, [2]string{"夏武烈帝赫连勃勃", "夏武烈帝赫連勃勃"}, 407, 6, 1},
+			{[2]string{"凤翔", "鳳翔"}, [2]string{"夏武烈帝赫连勃勃", "夏武烈帝赫連勃勃"}, 413, 3, 1},
+			{[2]string{"昌武", "昌武"}, [2]string{"夏武烈帝赫连勃勃", "夏武烈帝赫連勃勃"}, 418, 11, 1},
+			{[2]string{"真兴", "真興"}, [2]string{"夏武烈帝赫连勃勃", "夏武烈帝赫連勃勃"}, 419, 2, 1},
+			{[2]string{"承光", "承光"}, [2]string{"夏赫连昌", "夏赫連昌"}, 425, 8, 1},
+			{[2]string{"胜光", "勝光"}, [2]string{"夏赫连定", "夏赫連定"}, 428, 2, 1},
+		},
+		end: LunarDate{Year: 431, Month: 6, Day: 1},
+	},
+	{
+		name: [2]string{"北燕", "北燕"},
+		eras: []eraRecord{
+			{[2]string{"正始", "正始"}, [2]string{"北燕惠懿帝高云", "北燕惠懿帝高雲"}, 407, 7, 1},
+			{[2]string{"太平", "太平"}, [2]string{"北燕文成帝冯跋", "北燕文成帝馮跋"}, 409, 10, 1},
+			{[2]string{"太兴", "太興"}, [2]string{"北燕昭成帝冯弘", "北燕昭成帝馮弘"}, 431, 1, 1},
+		},
+		end: LunarDate{Year: 436, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"刘宋", "劉宋"},
+		eras: []eraRecord{
+			{[2]string{"永初", "永初"}, [2]string{"宋武帝刘裕", "宋武帝劉裕"}, 420, 6, 1},
+			{[2]string{"景平", "景平"}, [2]string{"宋少帝刘义符", "宋少帝劉義符"}, 423, 1, 1},
+			{[2]string{"元嘉", "元嘉"}, [2]string{"宋文帝刘义隆", "宋文帝劉義隆"}, 424, 8, 1},
+			{[2]string{"孝建", "孝建"}, [2]string{"宋孝武帝刘骏", "宋孝武帝劉駿"}, 454, 1, 1},
+			{[2]string{"大明", "大明"}, [2]string{"宋孝武帝刘骏", "宋孝武帝劉駿"}, 457, 1, 1},
+			{[2]string{"永光", "永光"}, [2]string{"宋前废帝刘子业", "宋前廢帝劉子業"}, 465, 1, 1},
+			{[2]string{"景和", "景和"}, [2]string{"宋前废帝刘子业", "宋前廢帝劉子業"}, 465, 8, 1},
+			{[2]string{"泰始", "泰始"}, [2]string{"宋明帝刘彧", "宋明帝劉彧"}, 465, 12, 1},
+			{[2]string{"泰豫", "泰豫"}, [2]string{"宋明帝刘彧", "宋明帝劉彧"}, 472, 1, 1},
+			{[2]string{"元徽", "元徽"}, [2]string{"宋后废帝刘昱", "宋後廢帝劉昱"}, 473, 1, 1},
+			{[2]string{"昇明", "昇明"}, [2]string{"宋顺帝刘准", "宋順帝劉準"}, 477, 7, 1},
+		},
+		end: LunarDate{Year: 479, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"南齐", "南齊"},
+		eras: []eraRecord{
+			{[2]string{"建元", "建元"}, [2]string{"齐高帝萧道成", "齊高帝蕭道成"}, 479, 4, 1},
+			{[2]string{"永明", "永明"}, [2]string{"齐武帝萧赜", "齊武帝蕭賾"}, 483, 1, 1},
+			{[2]string{"隆昌", "隆昌"}, [2]string{"郁林王萧昭业", "鬱林王蕭昭業"}, 494, 1, 1},
+			{[2]string{"延兴", "延興"}, [2]string{"海陵王萧昭文", "海陵王蕭昭文"}, 494, 7, 1},
+			{[2]string{"建武", "建武"}, [2]string{"齐明帝萧鸾", "齊明帝蕭鸞"}, 494, 10, 1},
+			{[2]string{"永泰", "永泰"}, [2]string{"齐明帝萧鸾", "齊明帝蕭鸞"}, 498, 4, 1},
+			{[2]string{"永元", "永元"}, [2]string{"东昏侯萧宝卷", "東昏侯蕭寶卷"}, 499, 1, 1},
+			{[2]string{"中兴", "中興"}, [2]string{"齐和帝萧宝融", "齊和帝蕭寶融"}, 501, 3, 1},
+		},
+		end: LunarDate{Year: 502, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"梁", "梁"},
+		eras: []eraRecord{
+			{[2]string{"天监", "天監"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 502, 4, 1},
+			{[2]string{"普通", "普通"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 520, 1, 1},
+			{[2]string{"大通", "大通"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 527, 3, 1},
+			{[2]string{"中大通", "中大通"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 529, 10, 1},
+			{[2]string{"大同", "大同"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 535, 1, 1},
+			{[2]string{"中大同", "中大同"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 546, 4, 1},
+			{[2]string{"太清", "太清"}, [2]string{"梁武帝萧衍", "梁武帝蕭衍"}, 547, 4, 1},
+			{[2]string{"大宝", "大寶"}, [2]string{"梁简文帝萧纲", "梁簡文帝蕭綱"}, 550, 1, 1},
+			{[2]string{"承圣", "承聖"}, [2]string{"梁元帝萧绎", "梁元帝蕭繹"}, 552, 11, 1},
+			{[2]string{"天成", "天成"}, [2]string{"贞阳侯萧渊明", "貞陽侯蕭淵明"}, 555, 5, 1},
+			{[2]string{"绍泰", "紹泰"}, [2]string{"梁敬帝萧方智", "梁敬帝蕭方智"}, 555, 10, 1},
+			{[2]string{"太平", "太平"}, [2]string{"梁敬帝萧方智", "梁敬帝蕭方智"}, 556, 9, 1},
+		},
+		end: LunarDate{Year: 557, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"东魏", "東魏"},
+		eras: []eraRecord{
+			{[2]string{"天平", "天平"}, [2]string{"东魏孝静帝元善见", "東魏孝靜帝元善見"}, 534, 10, 1},
+			{[2]string{"元象", "元象"}, [2]string{"东魏孝静帝元善见", "東魏孝靜帝元善見"}, 538, 1, 1},
+			{[2]string{"兴和", "興和"}, [2]string{"东魏孝静帝元善见", "東魏孝靜帝元善見"}, 539, 11, 1},
+			{[2]string{"武定", "武定"}, [2]string{"东魏孝静帝元善见", "東魏孝靜帝元善見"}, 543, 1, 1},
+		},
+		end: LunarDate{Year: 550, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"西魏", "西魏"},
+		eras: []eraRecord{
+			{[2]string{"大统", "大統"}, [2]string{"西魏文帝元宝炬", "西魏文帝元寶炬"}, 535, 1, 1},
+		},
+		end: LunarDate{Year: 552, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"北齐", "北齊"},
+		eras: []eraRecord{
+			{[2]string{"天保", "天保"}, [2]string{"北齐文宣帝高洋", "北齊文宣帝高洋"}, 550, 5, 1},
+			{[2]string{"乾明", "乾明"}, [2]string{"北齐废帝高殷", "北齊廢帝高殷"}, 560, 1, 1},
+			{[2]string{"皇建", "皇建"}, [2]string{"北齐孝昭帝高演", "北齊孝昭帝高演"}, 560, 8, 1},
+			{[2]string{"太宁", "太寧"}, [2]string{"北齐武成帝高湛", "北齊武成帝高湛"}, 561, 11, 1},
+			{[2]string{"河清", "河清"}, [2]string{"北齐武成帝高湛", "北齊武成帝高湛"}, 562, 4, 1},
+			{[2]string{"天统", "天統"}, [2]string{"北齐后主高纬", "北齊後主高緯"}, 565, 4, 1},
+			{[2]string{"武平", "武平"}, [2]string{"北齐后主高纬", "北齊後主高緯"}, 570, 1, 1},
+			{[2]string{"隆化", "隆化"}, [2]string{"北齐后主高纬", "北齊後主高緯"}, 576, 12, 1},
+			{[2]string{"承光", "承光"}, [2]string{"北齐幼主高恒", "北齊幼主高恒"}, 577, 1, 1},
+		},
+		end: LunarDate{Year: 577, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"陈", "陳"},
+		eras: []eraRecord{
+			{[2]string{"永定", "永定"}, [2]string{"陈武帝陈霸先", "陳武帝陳霸先"}, 557, 10, 1},
+			{[2]string{"天嘉", "天嘉"}, [2]string{"陈文帝陈蒨", "陳文帝陳蒨"}, 560, 1, 1},
+			{[2]string{"天康", "天康"}, [2]string{"陈文帝陈蒨", "陳文帝陳蒨"}, 566, 2, 1},
+			{[2]string{"光大", "光大"}, [2]string{"陈废帝陈伯宗", "陳廢帝陳伯宗"}, 567, 1, 1},
+			{[2]string{"太建", "太建"}, [2]string{"陈宣帝陈顼", "陳宣帝陳頊"}, 569, 1, 1},
+			{[2]string{"至德", "至德"}, [2]string{"陈后主陈叔宝", "陳後主陳叔寶"}, 583, 1, 1},
+			{[2]string{"祯明", "禎明"}, [2]string{"陈后主陈叔宝", "陳後主陳叔寶"}, 587, 1, 1},
+		},
+		end: LunarDate{Year: 589, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"北周", "北周"},
+		eras: []eraRecord{
+			{[2]string{"武成", "武成"}, [2]string{"北周明帝宇文毓", "北周明帝宇文毓"}, 559, 8, 1},
+			{[2]string{"保定", "保定"}, [2]string{"北周武帝宇文邕", "北周武帝宇文邕"}, 561, 1, 1},
+			{[2]string{"天和", "天和"}, [2]string{"北周武帝宇文邕", "北周武帝宇文邕"}, 566, 1, 1},
+			{[2]string{"建德", "建德"}, [2]string{"北周武帝宇文邕", "北周武帝宇文邕"}, 572, 3, 1},
+			{[2]string{"宣政", "宣政"}, [2]string{"北周武帝宇文邕", "北周武帝宇文邕"}, 578, 3, 1},
+			{[2]string{"大成", "大成"}, [2]string{"北周宣帝宇文赟", "北周宣帝宇文贇"}, 579, 1, 1},
+			{[2]string{"大象", "大象"}, [2]string{"北周静帝宇文阐", "北周靜帝宇文闡"}, 579, 2, 1},
+			{[2]string{"大定", "大定"}, [2]string{"北周静帝宇文阐", "北周靜帝宇文闡"}, 581, 1, 1},
+		},
+		end: LunarDate{Year: 581, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"隋", "隋"},
+		eras: []eraRecord{
+			{[2]string{"开皇", "開皇"}, [2]string{"隋文帝杨坚", "隋文帝楊堅"}, 581, 2, 1},
+			{[2]string{"仁寿", "仁壽"}, [2]string{"隋文帝杨坚", "隋文帝楊堅"}, 601, 1, 1},
+			{[2]string{"大业", "大業"}, [2]string{"隋炀帝杨广", "隋煬帝楊廣"}, 605, 1, 1},
+			{[2]string{"义宁", "義寧"}, [2]string{"隋恭帝杨侑", "隋恭帝楊侑"}, 617, 11, 1},
+		},
+		end: LunarDate{Year: 618, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"唐", "唐"},
+		eras: []eraRecord{
+			{[2]string{"武德", "武德"}, [2]string{"唐高祖李渊", "唐高祖李淵"}, 618, 5, 1},
+			{[2]string{"贞观", "貞觀"}, [2]string{"唐太宗李世民", "唐太宗李世民"}, 627, 1, 1},
+			{[2]string{"永徽", "永徽"}, [2]string{"唐高宗李治", "唐高宗李治"}, 650, 1, 1},
+			{[2]string{"显庆", "顯慶"}, [2]string{"唐高宗李治", "唐高宗李治"}, 656, 1, 1},
+			{[2]string{"龙朔", "龍朔"}, [2]string{"唐高宗李治", "唐高宗李治"}, 661, 3, 1},
+			{[2]string{"麟德", "麟德"}, [2]string{"唐高宗李治", "唐高宗李治"}, 664, 1, 1},
+			{[2]string{"乾封", "乾封"}, [2]string{"唐高宗李治", "唐高宗李治"}, 666, 1, 1},
+			{[2]string{"总章", "總章"}, [2]string{"唐高宗李治", "唐高宗李治"}, 668, 3, 1},
+			{[2]string{"咸亨", "咸亨"}, [2]string{"唐高宗李治", "唐高宗李治"}, 670, 3, 1},
+			{[2]string{"上元", "上元"}, [2]string{"唐高宗李治", "唐高宗李治"}, 674, 8, 1},
+			{[2]string{"仪凤", "儀鳳"}, [2]string{"唐高宗李治", "唐高宗李治"}, 676, 11, 1},
+			{[2]string{"调露", "調露"}, [2]string{"唐高宗李治", "唐高宗李治"}, 679, 6, 1},
+			{[2]string{"永隆", "永隆"}, [2]string{"唐高宗李治", "唐高宗李治"}, 680, 8, 1},
+			{[2]string{"开耀", "開耀"}, [2]string{"唐高宗李治", "唐高宗李治"}, 681, 9, 1},
+			{[2]string{"永淳", "永淳"}, [2]string{"唐高宗李治", "唐高宗李治"}, 682, 2, 1},
+			{[2]string{"弘道", "弘道"}, [2]string{"唐高宗李治", "唐高宗李治"}, 683, 12, 1},
+			{[2]string{"嗣圣", "嗣聖"}, [2]string{"唐中宗李显", "唐中宗李顯"}, 684, 1, 1},
+			{[2]string{"文明", "文明"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 684, 2, 1},
+			{[2]string{"光宅", "光宅"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 684, 9, 1},
+			{[2]string{"垂拱", "垂拱"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 685, 1, 1},
+			{[2]string{"永昌", "永昌"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 689, 1, 1},
+			{[2]string{"载初", "載初"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 689, 11, 1},
+			{[2]string{}, [2]string{}, 690, 9, 0},
+			{[2]string{"神龙", "神龍"}, [2]string{"唐中宗李显", "唐中宗李顯"}, 705, 2, 1},
+			{[2]string{"景龙", "景龍"}, [2]string{"唐中宗李显", "唐中宗李顯"}, 707, 9, 1},
+			{[2]string{"唐隆", "唐隆"}, [2]string{"唐殇帝李重茂", "唐殤帝李重茂"}, 710, 6, 1},
+			{[2]string{"景云", "景雲"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 710, 7, 1},
+			{[2]string{"太极", "太極"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 712, 1, 1},
+			{[2]string{"延和", "延和"}, [2]string{"唐睿宗李旦", "唐睿宗李旦"}, 712, 5, 1},
+			{[2]string{"先天", "先天"}, [2]string{"唐玄宗李隆基", "唐玄宗李隆基"}, 712, 8, 1},
+			{[2]string{"开元", "開元"}, [2]string{"唐玄宗李隆基", "唐玄宗李隆基"}, 713, 12, 1},
+			{[2]string{"天宝", "天寶"}, [2]string{"唐玄宗李隆基", "唐玄宗李隆基"}, 742, 1, 1},
+			{[2]string{"至德", "至德"}, [2]string{"唐肃宗李亨", "唐肅宗李亨"}, 756, 7, 1},
+			{[2]string{"乾元", "乾元"}, [2]string{"唐肃宗李亨", "唐肅宗李亨"}, 758, 2, 1},
+			{[2]string{"上元", "上元"}, [2]string{"唐肃宗李亨", "唐肅宗李亨"}, 760, -4, 1},
+			{[2]string{}, [2]string{}, 761, 9, 0},
+			{[2]string{"宝应", "寶應"}, [2]string{"唐代宗李豫", "唐代宗李豫"}, 762, 4, 1},
+			{[2]string{"广德", "廣德"}, [2]string{"唐代宗李豫", "唐代宗李豫"}, 763, 7, 1},
+			{[2]string{"永泰", "永泰"}, [2]string{"唐代宗李豫", "唐代宗李豫"}, 765, 1, 1},
+			{[2]string{"大历", "大曆"}, [2]string{"唐代宗李豫", "唐代宗李豫"}, 766, 11, 1},
+			{[2]string{"建中", "建中"}, [2]string{"唐德宗李适", "唐德宗李适"}, 780, 1, 1},
+			{[2]string{"兴元", "興元"}, [2]string{"唐德宗李适", "唐德宗李适"}, 784, 1, 1},
+			{[2]string{"贞元", "貞元"}, [2]string{"唐德宗李适", "唐德宗李适"}, 785, 1, 1},
+			{[2]string{"永贞", "永貞"}, [2]string{"唐顺宗李诵", "唐順宗李誦"}, 805, 8, 1},
+			{[2]string{"元和", "元和"}, [2]string{"唐宪宗李纯", "唐憲宗李純"}, 806, 1, 1},
+			{[2]string{"长庆", "長慶"}, [2]string{"唐穆宗李恒", "唐穆宗李恒"}, 821, 1, 1},
+			{[2]string{"宝历", "寶曆"}, [2]string{"唐敬宗李湛", "唐敬宗李湛"}, 825, 1, 1},
+			{[2]string{"大和", "大和"}, [2]string{"唐文宗李昂", "唐文宗李昂"}, 827, 2, 1},
+			{[2]string{"开成", "開成"}, [2]string{"唐文宗李昂", "唐文宗李昂"}, 836, 1, 1},
+			{[2]string{"会昌", "會昌"}, [2]string{"唐武宗李炎", "唐武宗李炎"}, 841, 1, 1},
+			{[2]string{"大中", "大中"}, [2]string{"唐宣宗李忱", "唐宣宗李忱"}, 847, 1, 1},
+			{[2]string{"咸通", "咸通"}, [2]string{"唐懿宗李漼", "唐懿宗李漼"}, 860, 11, 1},
+			{[2]string{"乾符", "乾符"}, [2]string{"唐僖宗李儇", "唐僖宗李儇"}, 874, 11, 1},
+			{[2]string{"广明", "廣明"}, [2]string{"唐僖宗李儇", "唐僖宗李儇"}, 880, 1, 1},
+			{[2]string{"中和", "中和"}, [2]string{"唐僖宗李儇", "唐僖宗李儇"}, 881, 7, 1},
+			{[2]string{"光启", "光啓"}, [2]string{"唐僖宗李儇", "唐僖宗李儇"}, 885, 3, 1},
+			{[2]string{"文德", "文德"}, [2]string{"唐僖宗李儇", "唐僖宗李儇"}, 888, 2, 1},
+			{[2]string{"龙纪", "龍紀"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 889, 1, 1},
+			{[2]string{"大顺", "大順"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 890, 1, 1},
+			{[2]string{"景福", "景福"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 892, 1, 1},
+			{[2]string{"乾宁", "乾寧"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 894, 1, 1},
+			{[2]string{"光化", "光化"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 898, 8, 1},
+			{[2]string{"天复", "天復"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 901, 4, 1},
+			{[2]string{"天祐", "天祐"}, [2]string{"唐昭宗李晔", "唐昭宗李曄"}, 904, -4, 1},
+		},
+		yearStarts: []eraYearStart{
+			{LunarDate{Year: 689, Month: 11, Day: 1}, LunarDate{Year: 700, Month: 11, Day: 1}, 11},
+		},
+		end: LunarDate{Year: 907, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"武周", "武周"},
+		eras: []eraRecord{
+			{[2]string{"天授", "天授"}, [2]string{"武则天", "武則天"}, 690, 9, 1},
+			{[2]string{"如意", "如意"}, [2]string{"武则天", "武則天"}, 692, 4, 1},
+			{[2]string{"长寿", "長壽"}, [2]string{"武则天", "武則天"}, 692, 9, 1},
+			{[2]string{"延载", "延載"}, [2]string{"武则天", "武則天"}, 694, 5, 1},
+			{[2]string{"证圣", "證聖"}, [2]string{"武则天", "武則天"}, 694, 11, 1},
+			{[2]string{"天册万岁", "天冊萬歲"}, [2]string{"武则天", "武則天"}, 695, 9, 1},
+			{[2]string{"万岁登封", "萬歲登封"}, [2]string{"武则天", "武則天"}, 695, 12, 1},
+			{[2]string{"万岁通天", "萬歲通天"}, [2]string{"武则天", "武則天"}, 696, 4, 1},
+			{[2]string{"神功", "神功"}, [2]string{"武则天", "武則天"}, 697, 9, 1},
+			{[2]string{"圣历", "聖曆"}, [2]string{"武则天", "武則天"}, 697, 11, 1},
+			{[2]string{"久视", "久視"}, [2]string{"武则天", "武則天"}, 700, 5, 1},
+			{[2]string{"大足", "大足"}, [2]string{"武则天", "武則天"}, 701, 1, 1},
+			{[2]string{"长安", "長安"}, [2]string{"武则天", "武則天"}, 701, 10, 1},
+			{[2]string{"神龙", "神龍"}, [2]string{"武则天", "武則天"}, 705, 1, 1},
+		},
+		yearStarts: []eraYearStart{
+			{LunarDate{Year: 689, Month: 11, Day: 1}, LunarDate{Year: 700, Month: 11, Day: 1}, 11},
+		},
+		end: LunarDate{Year: 705, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"后梁", "後梁"},
+		eras: []eraRecord{
+			{[2]string{"开平", "開平"}, [2]string{"后梁太祖朱温", "後梁太祖朱溫"}, 907, 4, 1},
+			{[2]string{"乾化", "乾化"}, [2]string{"后梁太祖朱温", "後梁太祖朱溫"}, 911, 5, 1},
+			{[2]string{"凤历", "鳳曆"}, [2]string{"后梁郢王朱友珪", "後梁郢王朱友珪"}, 913, 1, 1},
+			{[2]string{"乾化", "乾化"}, [2]string{"后梁末帝朱友贞", "後梁末帝朱友貞"}, 913, 2, 3},
+			{[2]string{"贞明", "貞明"}, [2]string{"后梁末帝朱友贞", "後梁末帝朱友貞"}, 915, 11, 1},
+			{[2]string{"龙德", "龍德"}, [2]string{"后梁末帝朱友贞", "後梁末帝朱友貞"}, 921, 5, 1},
+		},
+		end: LunarDate{Year: 923, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"前蜀", "前蜀"},
+		eras: []eraRecord{
+			{[2]string{"武成", "武成"}, [2]string{"前蜀高祖王建", "前蜀高祖王建"}, 908, 1, 1},
+			{[2]string{"永平", "永平"}, [2]string{"前蜀高祖王建", "前蜀高祖王建"}, 911, 1, 1},
+			{[2]string{"通正", "通正"}, [2]string{"前蜀高祖王建", "前蜀高祖王建"}, 916, 1, 1},
+			{[2]string{"天汉", "天漢"}, [2]string{"前蜀高祖王建", "前蜀高祖王建"}, 917, 1, 1},
+			{[2]string{"光天", "光天"}, [2]string{"前蜀高祖王建", "前蜀高祖王建"}, 918, 1, 1},
+			{[2]string{"乾德", "乾德"}, [2]string{"前蜀后主王衍", "前蜀後主王衍"}, 919, 1, 1},
+			{[2]string{"咸康", "咸康"}, [2]string{"前蜀后主王衍", "前蜀後主王衍"}, 925, 1, 1},
+		},
+		end: LunarDate{Year: 925, Month: 11, Day: 1},
+	},
+	{
+		name: [2]string{"辽", "遼"},
+		eras: []eraRecord{
+			{[2]string{"神册", "神冊"}, [2]string{"辽太祖耶律阿保机", "遼太祖耶律阿保機"}, 916, 12, 1},
+			{[2]string{"天赞", "天贊"}, [2]string{"辽太祖耶律阿保机", "遼太祖耶律阿保機"}, 922, 2, 1},
+			{[2]string{"天显", "天顯"}, [2]string{"辽太祖耶律阿保机", "遼太祖耶律阿保機"}, 926, 2, 1},
+			{[2]string{"会同", "會同"}, [2]string{"辽太宗耶律德光", "遼太宗耶律德光"}, 938, 11, 1},
+			{[2]string{"大同", "大同"}, [2]string{"辽太宗耶律德光", "遼太宗耶律德光"}, 947, 2, 1},
+			{[2]string{"天禄", "天祿"}, [2]string{"辽世宗耶律阮", "遼世宗耶律阮"}, 947, 9, 1},
+			{[2]string{"应历", "應曆"}, [2]string{"辽穆宗耶律璟", "遼穆宗耶律璟"}, 951, 9, 1},
+			{[2]string{"保宁", "保寧"}, [2]string{"辽景宗耶律贤", "遼景宗耶律賢"}, 969, 2, 1},
+			{[2]string{"乾亨", "乾亨"}, [2]string{"辽景宗耶律贤", "遼景宗耶律賢"}, 979, 11, 1},
+			{[2]string{"统和", "統和"}, [2]string{"辽圣宗耶律隆绪", "遼聖宗耶律隆緒"}, 983, 6, 1},
+			{[2]string{"开泰", "開泰"}, [2]string{"辽圣宗耶律隆绪", "遼聖宗耶律隆緒"}, 1012, 11, 1},
+			{[2]string{"太平", "太平"}, [2]string{"辽圣宗耶律隆绪", "遼聖宗耶律隆緒"}, 1021, 11, 1},
+			{[2]string{"景福", "景福"}, [2]string{"辽兴宗耶律宗真", "遼興宗耶律宗真"}, 1031, 6, 1},
+			{[2]string{"重熙", "重熙"}, [2]string{"辽兴宗耶律宗真", "遼興宗耶律宗真"}, 1032, 11, 1},
+			{[2]string{"清宁", "清寧"}, [2]string{"辽道宗耶律洪基", "遼道宗耶律洪基"}, 1055, 8, 1},
+			{[2]string{"咸雍", "咸雍"}, [2]string{"辽道宗耶律洪基", "遼道宗耶律洪基"}, 1065, 1, 1},
+			{[2]string{"大康", "大康"}, [2]string{"辽道宗耶律洪基", "遼道宗耶律洪基"}, 1075, 1, 1},
+			{[2]string{"大安", "大安"}, [2]string{"辽道宗耶律洪基", "遼道宗耶律洪基"}, 1085, 1, 1},
+			{[2]string{"寿昌", "壽昌"}, [2]string{"辽道宗耶律洪基", "遼道宗耶律洪基"}, 1095, 1, 1},
+			{[2]string{"乾统", "乾統"}, [2]string{"辽天祚帝耶律延禧", "遼天祚帝耶律延禧"}, 1101, 2, 1},
+			{[2]string{"天庆", "天慶"}, [2]string{"辽天祚帝耶律延禧", "遼天祚帝耶律延禧"}, 1111, 1, 1},
+			{[2]string{"保大", "保大"}, [2]string{"辽天祚帝耶律延禧", "遼天祚帝耶律延禧"}, 1121, 1, 1},
+		},
+		end: LunarDate{Year: 1125, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"南汉", "南漢"},
+		eras: []eraRecord{
+			{[2]string{"乾亨", "乾亨"}, [2]string{"南汉高祖刘䶮", "南漢高祖劉龑"}, 917, 8, 1},
+			{[2]string{"白龙", "白龍"}, [2]string{"南汉高祖刘䶮", "南漢高祖劉龑"}, 925, 12, 1},
+			{[2]string{"大有", "大有"}, [2]string{"南汉高祖刘䶮", "南漢高祖劉龑"}, 928, 3, 1},
+			{[2]string{"光天", "光天"}, [2]string{"南汉殇帝刘玢", "南漢殤帝劉玢"}, 942, 4, 1},
+			{[2]string{"应乾", "應乾"}, [2]string{"南汉中宗刘晟", "南漢中宗劉晟"}, 943, 3, 1},
+			{[2]string{"乾和", "乾和"}, [2]string{"南汉中宗刘晟", "南漢中宗劉晟"}, 943, 11, 1},
+			{[2]string{"大宝", "大寶"}, [2]string{"南汉后主刘鋹", "南漢後主劉鋹"}, 958, 8, 1},
+		},
+		end: LunarDate{Year: 971, Month: 2, Day: 1},
+	},
+	{
+		name: [2]string{"杨吴", "楊吳"},
+		eras: []eraRecord{
+			{[2]string{"武义", "武義"}, [2]string{"吴宣王杨隆演", "吳宣王楊隆演"}, 919, 4, 1},
+			{[2]string{"顺义", "順義"}, [2]string{"吴睿帝杨溥", "吳睿帝楊溥"}, 921, 2, 1},
+			{[2]string{"乾贞", "乾貞"}, [2]string{"吴睿帝杨溥", "吳睿帝楊溥"}, 927, 11, 1},
+			{[2]string{"大和", "大和"}, [2]string{"吴睿帝杨溥", "吳睿帝楊溥"}, 929, 10, 1},
+			{[2]string{"天祚", "天祚"}, [2]string{"吴睿帝杨溥", "吳睿帝楊溥"}, 935, 9, 1},
+		},
+		end: LunarDate{Year: 937, Month: 10, Day: 1},
+	},
+	{
+		name: [2]string{"后唐", "後唐"},
+		eras: []eraRecord{
+			{[2]string{"同光", "同光"}, [2]string{"后唐庄宗李存勖", "後唐莊宗李存勖"}, 923, 4, 1},
+			{[2]string{"天成", "天成"}, [2]string{"后唐明宗李嗣源", "後唐明宗李嗣源"}, 926, 4, 1},
+			{[2]string{"长兴", "長興"}, [2]string{"后唐明宗李嗣源", "後唐明宗李嗣源"}, 930, 2, 1},
+			{[2]string{"应顺", "應順"}, [2]string{"后唐闵帝李从厚", "後唐閔帝李從厚"}, 934, 1, 1},
+			{[2]string{"清泰", "清泰"}, [2]string{"后唐末帝李从珂", "後唐末帝李從珂"}, 934, 4, 1},
+		},
+		end: LunarDate{Year: 936, Month: 12, Day: 1},
+	},
+	{
+		name: [2]string{"闽", "閩"},
+		eras: []eraRecord{
+			{[2]string{"龙启", "龍啓"}, [2]string{"闽惠宗王延钧", "閩惠宗王延鈞"}, 933, 1, 1},
+			{[2]string{"永和", "永和"}, [2]string{"闽惠宗王延钧", "閩惠宗王延鈞"}, 935, 1, 1},
+			{[2]string{"通文", "通文"}, [2]string{"闽康宗王继鹏", "閩康宗王繼鵬"}, 936, 1, 1},
+			{[2]string{"永隆", "永隆"}, [2]string{"闽景宗王曦", "閩景宗王曦"}, 939, 8, 1},
+		},
+		end: LunarDate{Year: 944, Month: 3, Day: 1},
+	},
+	{
+		name: [2]string{"后蜀", "後蜀"},
+		eras: []eraRecord{
+			{[2]string{"明德", "明德"}, [2]string{"后蜀高祖孟知祥", "後蜀高祖孟知祥"}, 934, 4, 1},
+			{[2]string{"广政", "廣政"}, [2]string{"后蜀后主孟昶", "後蜀後主孟昶"}, 938, 1, 1},
+		},
+		end: LunarDate{Year: 965, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"后晋", "後晉"},
+		eras: []eraRecord{
+			{[2]string{"天福", "天福"}, [2]string{"后晋高祖石敬瑭", "後晉高祖石敬瑭"}, 936, 11, 1},
+			{[2]string{"开运", "開運"}, [2]string{"后晋出帝石重贵", "後晉出帝石重貴"}, 944, 7, 1},
+		},
+		end: LunarDate{Year: 947, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"南唐", "南唐"},
+		eras: []eraRecord{
+			{[2]string{"升元", "昇元"}, [2]string{"南唐烈祖李昪", "南唐烈祖李昪"}, 937, 10, 1},
+			{[2]string{"保大", "保大"}, [2]string{"南唐元宗李璟", "南唐元宗李璟"}, 943, 3, 1},
+			{[2]string{"交泰", "交泰"}, [2]string{"南唐元宗李璟", "南唐元宗李璟"}, 958, 3, 1},
+		},
+		end: LunarDate{Year: 958, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"后汉", "後漢"},
+		eras: []eraRecord{
+			{[2]string{"天福", "天福"}, [2]string{"后汉高祖刘知远", "後漢高祖劉知遠"}, 947, 2, 12},
+			{[2]string{"乾祐", "乾祐"}, [2]string{"后汉高祖刘知远", "後漢高祖劉知遠"}, 948, 1, 1},
+		},
+		end: LunarDate{Year: 951, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"后周", "後周"},
+		eras: []eraRecord{
+			{[2]string{"广顺", "廣順"}, [2]string{"后周太祖郭威", "後周太祖郭威"}, 951, 1, 1},
+			{[2]string{"显德", "顯德"}, [2]string{"后周太祖郭威", "後周太祖郭威"}, 954, 1, 1},
+		},
+		end: LunarDate{Year: 960, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"北汉", "北漢"},
+		eras: []eraRecord{
+			{[2]string{"乾祐", "乾祐"}, [2]string{"北汉世祖刘崇", "北漢世祖劉崇"}, 951, 1, 4},
+			{[2]string{"天会", "天會"}, [2]string{"北汉睿宗刘钧", "北漢睿宗劉鈞"}, 957, 1, 1},
+			{[2]string{"广运", "廣運"}, [2]string{"北汉刘继元", "北漢劉繼元"}, 974, 1, 1},
+		},
+		end: LunarDate{Year: 979, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"北宋", "北宋"},
+		eras: []eraRecord{
+			{[2]string{"建隆", "建隆"}, [2]string{"宋太祖赵匡胤", "宋太祖趙匡胤"}, 960, 1, 1},
+			{[2]string{"乾德", "乾德"}, [2]string{"宋太祖赵匡胤", "宋太祖趙匡胤"}, 963, 11, 1},
+			{[2]string{"开宝", "開寶"}, [2]string{"宋太祖赵匡胤", "宋太祖趙匡胤"}, 968, 11, 1},
+			{[2]string{"太平兴国", "太平興國"}, [2]string{"宋太宗赵炅", "宋太宗趙炅"}, 976, 12, 1},
+			{[2]string{"雍熙", "雍熙"}, [2]string{"宋太宗赵炅", "宋太宗趙炅"}, 984, 11, 1},
+			{[2]string{"端拱", "端拱"}, [2]string{"宋太宗赵炅", "宋太宗趙炅"}, 988, 1, 1},
+			{[2]string{"淳化", "淳化"}, [2]string{"宋太宗赵炅", "宋太宗趙炅"}, 990, 1, 1},
+			{[2]string{"至道", "至道"}, [2]string{"宋太宗赵炅", "宋太宗趙炅"}, 995, 1, 1},
+			{[2]string{"咸平", "咸平"}, [2]string{"宋真宗赵恒", "宋真宗趙恒"}, 998, 1, 1},
+			{[2]string{"景德", "景德"}, [2]string{"宋真宗赵恒", "宋真宗趙恒"}, 1004, 1, 1},
+			{[2]string{"大中祥符", "大中祥符"}, [2]string{"宋真宗赵恒", "宋真宗趙恒"}, 1008, 1, 1},
+			{[2]string{"天禧", "天禧"}, [2]string{"宋真宗赵恒", "宋真宗趙恒"}, 1017, 1, 1},
+			{[2]string{"乾兴", "乾興"}, [2]string{"宋真宗赵恒", "宋真宗趙恒"}, 1022, 1, 1},
+			{[2]string{"天圣", "天聖"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1023, 1, 1},
+			{[2]string{"明道", "明道"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1032, 11, 1},
+			{[2]string{"景祐", "景祐"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1034, 1, 1},
+			{[2]string{"宝元", "寶元"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1038, 11, 1},
+			{[2]string{"康定", "康定"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1040, 2, 1},
+			{[2]string{"庆历", "慶曆"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1041, 11, 1},
+			{[2]string{"皇祐", "皇祐"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1049, 1, 1},
+			{[2]string{"至和", "至和"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1054, 3, 1},
+			{[2]string{"嘉祐", "嘉祐"}, [2]string{"宋仁宗赵祯", "宋仁宗趙禎"}, 1056, 9, 1},
+			{[2]string{"治平", "治平"}, [2]string{"宋英宗赵曙", "宋英宗趙曙"}, 1064, 1, 1},
+			{[2]string{"熙宁", "熙寧"}, [2]string{"宋神宗赵顼", "宋神宗趙頊"}, 1068, 1, 1},
+			{[2]string{"元丰", "元豐"}, [2]string{"宋神宗赵顼", "宋神宗趙頊"}, 1078, 1, 1},
+			{[2]string{"元祐", "元祐"}, [2]string{"宋哲宗赵煦", "宋哲宗趙煦"}, 1086, 1, 1},
+			{[2]string{"绍圣", "紹聖"}, [2]string{"宋哲宗赵煦", "宋哲宗趙煦"}, 1094, 4, 1},
+			{[2]string{"元符", "元符"}, [2]string{"宋哲宗赵煦", "宋哲宗趙煦"}, 1098, 6, 1},
+			{[2]string{"建中靖国", "建中靖國"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1101, 1, 1},
+			{[2]string{"崇宁", "崇寧"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1102, 1, 1},
+			{[2]string{"大观", "大觀"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1107, 1, 1},
+			{[2]string{"政和", "政和"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1111, 1, 1},
+			{[2]string{"重和", "重和"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1118, 11, 1},
+			{[2]string{"宣和", "宣和"}, [2]string{"宋徽宗赵佶", "宋徽宗趙佶"}, 1119, 2, 1},
+			{[2]string{"靖康", "靖康"}, [2]string{"宋钦宗赵桓", "宋欽宗趙桓"}, 1126, 1, 1},
+		},
+		end: LunarDate{Year: 1127, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"金", "金"},
+		eras: []eraRecord{
+			{[2]string{"收国", "收國"}, [2]string{"金太祖完颜阿骨打", "金太祖完顏阿骨打"}, 1115, 1, 1},
+			{[2]string{"天辅", "天輔"}, [2]string{"金太祖完颜阿骨打", "金太祖完顏阿骨打"}, 1117, 1, 1},
+			{[2]string{"天会", "天會"}, [2]string{"金太宗完颜晟", "金太宗完顏晟"}, 1123, 9, 1},
+			{[2]string{"天眷", "天眷"}, [2]string{"金熙宗完颜亶", "金熙宗完顏亶"}, 1138, 1, 1},
+			{[2]string{"皇统", "皇統"}, [2]string{"金熙宗完颜亶", "金熙宗完顏亶"}, 1141, 1, 1},
+			{[2]string{"天德", "天德"}, [2]string{"海陵王完颜亮", "海陵王完顏亮"}, 1149, 12, 1},
+			{[2]string{"贞元", "貞元"}, [2]string{"海陵王完颜亮", "海陵王完顏亮"}, 1153, 3, 1},
+			{[2]string{"正隆", "正隆"}, [2]string{"海陵王完颜亮", "海陵王完顏亮"}, 1156, 2, 1},
+			{[2]string{"大定", "大定"}, [2]string{"金世宗完颜雍", "金世宗完顏雍"}, 1161, 10, 1},
+			{[2]string{"明昌", "明昌"}, [2]string{"金章宗完颜璟", "金章宗完顏璟"}, 1190, 1, 1},
+			{[2]string{"承安", "承安"}, [2]string{"金章宗完颜璟", "金章宗完顏璟"}, 1196, 11, 1},
+			{[2]string{"泰和", "泰和"}, [2]string{"金章宗完颜璟", "金章宗完顏璟"}, 1201, 1, 1},
+			{[2]string{"大安", "大安"}, [2]string{"卫绍王完颜永济", "衛紹王完顏永濟"}, 1209, 1, 1},
+			{[2]string{"崇庆", "崇慶"}, [2]string{"卫绍王完颜永济", "衛紹王完顏永濟"}, 1212, 1, 1},
+			{[2]string{"至宁", "至寧"}, [2]string{"卫绍王完颜永济", "衛紹王完顏永濟"}, 1213, 5, 1},
+			{[2]string{"贞祐", "貞祐"}, [2]string{"金宣宗完颜珣", "金宣宗完顏珣"}, 1213, 9, 1},
+			{[2]string{"兴定", "興定"}, [2]string{"金宣宗完颜珣", "金宣宗完顏珣"}, 1217, 9, 1},
+			{[2]string{"元光", "元光"}, [2]string{"金宣宗完颜珣", "金宣宗完顏珣"}, 1222, 8, 1},
+			{[2]string{"正大", "正大"}, [2]string{"金哀宗完颜守绪", "金哀宗完顏守緒"}, 1224, 1, 1},
+			{[2]string{"开兴", "開興"}, [2]string{"金哀宗完颜守绪", "金哀宗完顏守緒"}, 1232, 1, 1},
+			{[2]string{"天兴", "天興"}, [2]string{"金哀宗完颜守绪", "金哀宗完顏守緒"}, 1232, 4, 1},
+		},
+		end: LunarDate{Year: 1234, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"南宋", "南宋"},
+		eras: []eraRecord{
+			{[2]string{"建炎", "建炎"}, [2]string{"宋高宗赵构", "宋高宗趙構"}, 1127, 5, 1},
+			{[2]string{"绍兴", "紹興"}, [2]string{"宋高宗赵构", "宋高宗趙構"}, 1131, 1, 1},
+			{[2]string{"隆兴", "隆興"}, [2]string{"宋孝宗赵昚", "宋孝宗趙昚"}, 1163, 1, 1},
+			{[2]string{"乾道", "乾道"}, [2]string{"宋孝宗赵昚", "宋孝宗趙昚"}, 1165, 1, 1},
+			{[2]string{"淳熙", "淳熙"}, [2]string{"宋孝宗赵昚", "宋孝宗趙昚"}, 1174, 1, 1},
+			{[2]string{"绍熙", "紹熙"}, [2]string{"宋光宗赵惇", "宋光宗趙惇"}, 1190, 1, 1},
+			{[2]string{"庆元", "慶元"}, [2]string{"宋宁宗赵扩", "宋寧宗趙擴"}, 1195, 1, 1},
+			{[2]string{"嘉泰", "嘉泰"}, [2]string{"宋宁宗赵扩", "宋寧宗趙擴"}, 1201, 1, 1},
+			{[2]string{"开禧", "開禧"}, [2]string{"宋宁宗赵扩", "宋寧宗趙擴"}, 1205, 1, 1},
+			{[2]string{"嘉定", "嘉定"}, [2]string{"宋宁宗赵扩", "宋寧宗趙擴"}, 1208, 1, 1},
+			{[2]string{"宝庆", "寶慶"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1225, 1, 1},
+			{[2]string{"绍定", "紹定"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1228, 1, 1},
+			{[2]string{"端平", "端平"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1234, 1, 1},
+			{[2]string{"嘉熙", "嘉熙"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1237, 1, 1},
+			{[2]string{"淳祐", "淳祐"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1241, 1, 1},
+			{[2]string{"宝祐", "寶祐"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1253, 1, 1},
+			{[2]string{"开庆", "開慶"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1259, 1, 1},
+			{[2]string{"景定", "景定"}, [2]string{"宋理宗赵昀", "宋理宗趙昀"}, 1260, 1, 1},
+			{[2]string{"咸淳", "咸淳"}, [2]string{"宋度宗赵禥", "宋度宗趙禥"}, 1265, 1, 1},
+			{[2]string{"德祐", "德祐"}, [2]string{"宋恭帝赵㬎", "宋恭帝趙㬎"}, 1275, 1, 1},
+			{[2]string{"景炎", "景炎"}, [2]string{"宋端宗赵昰", "宋端宗趙昰"}, 1276, 5, 1},
+			{[2]string{"祥兴", "祥興"}, [2]string{"宋末帝赵昺", "宋末帝趙昺"}, 1278, 5, 1},
+		},
+		end: LunarDate{Year: 1279, Month: 3, Day: 1},
+	},
+	{
+		name: [2]string{"元", "元"},
+		eras: []eraRecord{
+			{[2]string{"中统", "中統"}, [2]string{"元世祖忽必烈", "元世祖忽必烈"}, 1260, 5, 1},
+			{[2]string{"至元", "至元"}, [2]string{"元世祖忽必烈", "元世祖忽必烈"}, 1264, 8, 1},
+			{[2]string{"元贞", "元貞"}, [2]string{"元成宗铁穆耳", "元成宗鐵穆耳"}, 1295, 1, 1},
+			{[2]string{"大德", "大德"}, [2]string{"元成宗铁穆耳", "元成宗鐵穆耳"}, 1297, 2, 1},
+			{[2]string{"至大", "至大"}, [2]string{"元武宗海山", "元武宗海山"}, 1308, 1, 1},
+			{[2]string{"皇庆", "皇慶"}, [2]string{"元仁宗爱育黎拔力八达", "元仁宗愛育黎拔力八達"}, 1312, 1, 1},
+			{[2]string{"延祐", "延祐"}, [2]string{"元仁宗爱育黎拔力八达", "元仁宗愛育黎拔力八達"}, 1314, 1, 1},
+			{[2]string{"至治", "至治"}, [2]string{"元英宗硕德八剌", "元英宗碩德八剌"}, 1321, 1, 1},
+			{[2]string{"泰定", "泰定"}, [2]string{"泰定帝也孙铁木儿", "泰定帝也孫鐵木兒"}, 1324, 1, 1},
+			{[2]string{"致和", "致和"}, [2]string{"泰定帝也孙铁木儿", "泰定帝也孫鐵木兒"}, 1328, 2, 1},
+			{[2]string{"天历", "天曆"}, [2]string{"元文宗图帖睦尔", "元文宗圖帖睦爾"}, 1328, 9, 1},
+			{[2]string{"至顺", "至順"}, [2]string{"元文宗图帖睦尔", "元文宗圖帖睦爾"}, 1330, 5, 1},
+			{[2]string{"元统", "元統"}, [2]string{"元惠宗妥懽帖睦尔", "元惠宗妥懽帖睦爾"}, 1333, 10, 1},
+			{[2]string{"至元", "至元"}, [2]string{"元惠宗妥懽帖睦尔", "元惠宗妥懽帖睦爾"}, 1335, 11, 1},
+			{[2]string{"至正", "至正"}, [2]string{"元惠宗妥懽帖睦尔", "元惠宗妥懽帖睦爾"}, 1341, 1, 1},
+		},
+		end: LunarDate{Year: 1370, Month: 5, Day: 1},
+	},
+	{
+		name: [2]string{"明", "明"},
+		eras: []eraRecord{
+			{[2]string{"洪武", "洪武"}, [2]string{"明太祖朱元璋", "明太祖朱元璋"}, 1368, 1, 1},
+			{[2]string{"建文", "建文"}, [2]string{"明惠帝朱允炆", "明惠帝朱允炆"}, 1399, 1, 1},
+			{[2]string{"洪武", "洪武"}, [2]string{"明成祖朱棣", "明成祖朱棣"}, 1402, 7, 35},
+			{[2]string{"永乐", "永樂"}, [2]string{"明成祖朱棣", "明成祖朱棣"}, 1403, 1, 1},
+			{[2]string{"洪熙", "洪熙"}, [2]string{"明仁宗朱高炽", "明仁宗朱高熾"}, 1425, 1, 1},
+			{[2]string{"宣德", "宣德"}, [2]string{"明宣宗朱瞻基", "明宣宗朱瞻基"}, 1426, 1, 1},
+			{[2]string{"正统", "正統"}, [2]string{"明英宗朱祁镇", "明英宗朱祁鎮"}, 1436, 1, 1},
+			{[2]string{"景泰", "景泰"}, [2]string{"明代宗朱祁钰", "明代宗朱祁鈺"}, 1450, 1, 1},
+			{[2]string{"天顺", "天順"}, [2]string{"明英宗朱祁镇", "明英宗朱祁鎮"}, 1457, 1, 1},
+			{[2]string{"成化", "成化"}, [2]string{"明宪宗朱见深", "明憲宗朱見深"}, 1465, 1, 1},
+			{[2]string{"弘治", "弘治"}, [2]string{"明孝宗朱祐樘", "明孝宗朱祐樘"}, 1488, 1, 1},
+			{[2]string{"正德", "正德"}, [2]string{"明武宗朱厚照", "明武宗朱厚照"}, 1506, 1, 1},
+			{[2]string{"嘉靖", "嘉靖"}, [2]string{"明世宗朱厚熜", "明世宗朱厚熜"}, 1522, 1, 1},
+			{[2]string{"隆庆", "隆慶"}, [2]string{"明穆宗朱载坖", "明穆宗朱載坖"}, 1567, 1, 1},
+			{[2]string{"万历", "萬曆"}, [2]string{"明神宗朱翊钧", "明神宗朱翊鈞"}, 1573, 1, 1},
+			{[2]string{"泰昌", "泰昌"}, [2]string{"明光宗朱常洛", "明光宗朱常洛"}, 1620, 8, 1},
+			{[2]string{"天启", "天啓"}, [2]string{"明熹宗朱由校", "明熹宗朱由校"}, 1621, 1, 1},
+			{[2]string{"崇祯", "崇禎"}, [2]string{"明思宗朱由检", "明思宗朱由檢"}, 1628, 1, 1},
+		},
+		end: LunarDate{Year: 1645, Month: 1, Day: 1},
+	},
+	{
+		name: [2]string{"后金", "後金"},
+		eras: []eraRecord{
+			{[2]string{"天命", "天命"}, [2]string{"清太祖努尔哈赤", "清太祖努爾哈赤"}, 1616, 1, 1},
+			{[2]string{"天聪", "天聰"}, [2]string{"清太宗皇太极", "清太宗皇太極"}, 1627, 1, 1},
+		},
+		end: LunarDate{Year: 1636, Month: 4, Day: 1},
+	},
+	{
+		name: [2]string{"清", "清"},
+		eras: []eraRecord{
+			{[2]string{"崇德", "崇德"}, [2]string{"清太宗皇太极", "清太宗皇太極"}, 1636, 4, 1},
+			{[2]string{"顺治", "順治"}, [2]string{"清世祖福临", "清世祖福臨"}, 1644, 1, 1},
+			{[2]string{"康熙", "康熙"}, [2]string{"清圣祖玄烨", "清聖祖玄燁"}, 1662, 1, 1},
+			{[2]string{"雍正", "雍正"}, [2]string{"清世宗胤禛", "清世宗胤禛"}, 1723, 1, 1},
+			{[2]string{"乾隆", "乾隆"}, [2]string{"清高宗弘历", "清高宗弘曆"}, 1736, 1, 1},
+			{[2]string{"嘉庆", "嘉慶"}, [2]string{"清仁宗颙琰", "清仁宗顒琰"}, 1796, 1, 1},
+			{[2]string{"道光", "道光"}, [2]string{"清宣宗旻宁", "清宣宗旻寧"}, 1821, 1, 1},
+			{[2]string{"咸丰", "咸豐"}, [2]string{"清文宗奕詝", "清文宗奕詝"}, 1851, 1, 1},
+			{[2]string{"同治", "同治"}, [2]string{"清穆宗载淳", "清穆宗載淳"}, 1862, 1, 1},
+			{[2]string{"光绪", "光緒"}, [2]string{"清德宗载湉", "清德宗載湉"}, 1875, 1, 1},
+			{[2]string{"宣统", "宣統"}, [2]string{"清逊帝溥仪", "清遜帝溥儀"}, 1909, 1, 1},
+		},
+		// 宣统三年十二月二十五日(1912年2月12日)清帝退位
+		end: LunarDate{Year: 1911, Month: 12, Day: 26},
+	},
+	{
+		name: [2]string{"南明", "南明"},
+		eras: []eraRecord{
+			{[2]string{"弘光", "弘光"}, [2]string{"明安宗朱由崧", "明安宗朱由崧"}, 1645, 1, 1},
+			{[2]string{}, [2]string{}, 1645, 6, 0},
+			{[2]string{"隆武", "隆武"}, [2]string{"明绍宗朱聿键", "明紹宗朱聿鍵"}, 1645, -6, 1},
+			{[2]string{"永历", "永曆"}, [2]string{"明昭宗朱由榔", "明昭宗朱由榔"}, 1647, 1, 1},
+		},
+		end: LunarDate{Year: 1662, Month: 4, Day: 1},
+	},
+}
+
+// eras 由eraRegimes展开的全部年号
+var eras = func() []Era {
+	var list []Era
+	for idx, r := range eraRegimes {
+		for i, rec := range r.eras {
+			if rec.name[0] == "" {
+				continue
+			}
+			end := r.end
+			if i+1 < len(r.eras) {
+				next := r.eras[i+1]
+				end = eraRecordStart(next.year, next.month)
+			}
+			list = append(list, Era{
+				name:     rec.name,
+				regime:   &eraRegimes[idx],
+				emperor:  rec.emperor,
+				start:    eraRecordStart(rec.year, rec.month),
+				end:      end,
+				firstNum: rec.firstNum,
+			})
+		}
+	}
+	return list
+}()
+
+// eraRecordStart 返回年号数据表中year年month月初一的农历日期, month为负数表示闰月
+func eraRecordStart(year, month int) LunarDate {
+	if month < 0 {
+		return LunarDate{Year: year, Month: -month, Day: 1, IsLeapMonth: true}
+	}
+	return LunarDate{Year: year, Month: month, Day: 1}
+}
+
+// Eras 返回收录的全部年号, 按政权分组, 同一政权内按时间顺序排列
+func Eras() []Era {
+	list := make([]Era, len(eras))
+	copy(list, eras)
+	return list
+}
+
+// Name 返回年号名称
+func (e Era) Name(simplified bool) string {
+	return pickWord(e.name, simplified)
+}
+
+// Regime 返回使用该年号的政权
+func (e Era) Regime(simplified bool) string {
+	if e.regime == nil {
+		return ""
+	}
+	return pickWord(e.regime.name, simplified)
+}
+
+// Emperor 返回使用该年号的皇帝, 以庙号(或谥号, 封号)加姓名表示
+func (e Era) Emperor(simplified bool) string {
+	return pickWord(e.emperor, simplified)
+}
+
+// Start 返回启用该年号的农历日期
+func (e Era) Start() LunarDate {
+	return e.start
+}
+
+// End 返回停用该年号的农历日期, 该日已不属于此年号
+func (e Era) End() LunarDate {
+	return e.end
+}
+
+// Contains 农历日期d是否处于该年号的使用期间
+func (e Era) Contains(d LunarDate) bool {
+	return !lunarDateBefore(d, e.start) && lunarDateBefore(d, e.end)
+}
+
+// EraDate 年号纪年的日期, 月日与农历相同
+// 例: 康熙六十一年十一月十三日, 即LunarDate{Year: 1722, Month: 11, Day: 13}
+type EraDate struct {
+	Era Era
+	// Year 年数, 1为元年
+	Year        int
+	Month       int
+	Day         int
+	IsLeapMonth bool
+}
+
+// NewEraDates 返回农历日期d在各政权下的年号纪年
+// 同一时期可能有多个政权并立, 如三国, 十六国, 南北朝, 五代十国, 宋与辽金, 明与后金, 因此返回值可能有多项, 不在收录范围内时返回空
+func NewEraDates(d LunarDate) []EraDate {
+	var dates []EraDate
+	for _, e := range eras {
+		if e.Contains(d) {
+			dates = append(dates, EraDate{
+				Era:         e,
+				Year:        e.regime.yearOf(d) - e.regime.yearOf(e.start) + e.firstNum,
+				Month:       d.Month,
+				Day:         d.Day,
+				IsLeapMonth: d.IsLeapMonth,
+			})
+		}
+	}
+	return dates
+}
+
+// ParseEraDate 解析年号纪年的日期, 如"康熙六十一年十一月十三日", "崇祯四年闰十一月初一日"
+// 同名年号(如魏与吴的甘露)会各自返回一项, 日期不在年号使用期间内的不会返回; 无法解析时第二个返回值为false
+func ParseEraDate(text string) ([]EraDate, bool) {
+	// 取最长的匹配, 以免"太平真君"被当作"太平"
+	name := ""
+	for _, e := range eras {
+		for _, each := range e.name {
+			if strings.HasPrefix(text, each) && len(each) > len(name) {
+				name = each
+			}
+		}
+	}
+	if name == "" {
+		return nil, false
+	}
+
+	d, ok := parseEraDateBody(strings.TrimPrefix(text, name))
+	if !ok {
+		return nil, false
+	}
+	return erasDatesNamed(name, d), true
+}
+
+// erasDatesNamed 返回名为name且包含d所表示日期的各年号下的日期
+func erasDatesNamed(name string, d EraDate) []EraDate {
+	var dates []EraDate
+	for _, e := range eras {
+		if e.name[0] != name && e.name[1] != name {
+			continue
+		}
+		ed := d
+		ed.Era = e
+		if e.Contains(ed.LunarDate()) {
+			dates = append(dates, ed)
+		}
+	}
+	return dates
+}
+
+// LunarDate 返回该日期对应的农历日期, 年内重复出现的月份(见eraYearStart)取前者
+func (d EraDate) LunarDate() LunarDate {
+	r := d.Era.regime
+	year := r.yearOf(d.Era.start) + d.Year - d.Era.firstNum
+	ld := LunarDate{
+		Year:        year,
+		Month:       d.Month,
+		Day:         d.Day,
+		IsLeapMonth: d.IsLeapMonth,
+	}
+	// 岁首不在正月时, 岁首及之后的月在农历上属于前一年;
+	// 改换岁首的年份(如太初元年)有十五个月, 重复出现且都在该年号使用期间内的月份取前者
+	prev := ld
+	prev.Year--
+	if r.yearOf(prev) == year && (d.Era.Contains(prev) || !d.Era.Contains(ld)) {
+		return prev
+	}
+	return ld
+}
+
+// String 返回年号纪年的中文, 如"康熙六十一年十一月十三日", "崇禎四年閏十一月初一日"
+func (d EraDate) String(simplified bool) string {
+	if d.Year < 1 || d.Year > 99 || d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return ""
+	}
+
+	year := chineseNumber(d.Year)
+	if d.Year == 1 {
+		year = "元"
+	}
+	month := chineseNumber(d.Month)
+	if d.Month == 1 {
+		month = "正"
+	}
+	leap := ""
+	if d.IsLeapMonth {
+		leap = pickWord([2]string{"闰", "閏"}, simplified)
+	}
+	day := chineseNumber(d.Day)
+	if d.Day <= 10 {
+		day = "初" + day
+	}
+
+	return d.Era.Name(simplified) + year + "年" + leap + month + "月" + day + "日"
+}
+
+// parseEraDateBody 解析年号之后的部分, 如"六十一年十一月十三日"
+func parseEraDateBody(text string) (EraDate, bool) {
+	var d EraDate
+	var ok bool
+
+	idx := strings.Index(text, "年")
+	if idx < 0 {
+		return d, false
+	}
+	if year := text[:idx]; year == "元" {
+		d.Year = 1
+	} else if d.Year, ok = parseChineseNumber(year); !ok {
+		return d, false
+	}
+	text = text[idx+len("年"):]
+
+	for _, leap := range []string{"闰", "閏"} {
+		if strings.HasPrefix(text, leap) {
+			d.IsLeapMonth = true
+			text = strings.TrimPrefix(text, leap)
+		}
+	}
+	idx = strings.Index(text, "月")
+	if idx < 0 {
+		return d, false
+	}
+	switch month := text[:idx]; month {
+	case "正":
+		d.Month = 1
+	case "冬":
+		d.Month = 11
+	case "腊", "臘":
+		d.Month = 12
+	default:
+		if d.Month, ok = parseChineseNumber(month); !ok || d.Month > 12 {
+			return d, false
+		}
+	}
+	text = text[idx+len("月"):]
+
+	day := strings.TrimPrefix(strings.TrimSuffix(text, "日"), "初")
+	if d.Day, ok = parseChineseNumber(day); !ok || d.Day > 30 {
+		return d, false
+	}
+
+	return d, true
+}
+
+// chineseDigits 中文数字
+var chineseDigits = [10]string{"〇", "一", "二", "三", "四", "五", "六", "七", "八", "九"}
+
+// chineseNumber 返回1-99的中文数字, 如十, 十三, 六十一
+func chineseNumber(n int) string {
+	tens, ones := n/10, n%10
+	s := ""
+	if tens > 1 {
+		s = chineseDigits[tens]
+	}
+	if tens > 0 {
+		s += "十"
+	}
+	if ones > 0 {
+		s += chineseDigits[ones]
+	}
+	return s
+}
+
+// parseChineseNumber 解析1-99的中文数字, 兼容廿(二十)与卅(三十)
+func parseChineseNumber(s string) (int, bool) {
+	s = strings.NewReplacer("廿", "二十", "卅", "三十").Replace(s)
+	for n := 1; n < 100; n++ {
+		if chineseNumber(n) == s {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// lunarDateBefore 农历日期a是否早于b, 闰月在同名的月之后
+func lunarDateBefore(a, b LunarDate) bool {
+	if a.Year != b.Year {
+		return a.Year < b.Year
+	}
+	if a.Month != b.Month {
+		return a.Month < b.Month
+	}
+	if a.IsLeapMonth != b.IsLeapMonth {
+		return b.IsLeapMonth
+	}
+	return a.Day < b.Day
+}
+
+// pickWord 从简繁两种写法中选取
+func pickWord(words [2]string, simplified bool) string {
+	if simplified {
+		return words[0]
+	}
+	return words[1]
+}
@@ -0,0 +1,218 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewEraDates(t *testing.T) {
+	inputs := []LunarDate{
+		{Year: 1722, Month: 11, Day: 13},
+		{Year: 1402, Month: 6, Day: 30},
+		{Year: 1402, Month: 7, Day: 1},
+		{Year: 1620, Month: 7, Day: 29},
+		{Year: 1620, Month: 8, Day: 1},
+		{Year: 1631, Month: 11, Day: 1, IsLeapMonth: true},
+		{Year: 1911, Month: 12, Day: 25},
+		{Year: 1911, Month: 12, Day: 26},
+		{Year: 1368, Month: 1, Day: 1},
+		{Year: 1367, Month: 12, Day: 30},
+	}
+	expect := [][]string{
+		{"康熙六十一年十一月十三日"},
+		{"建文四年六月三十日"},
+		{"洪武三十五年七月初一日"},
+		{"万历四十八年七月二十九日", "天命五年七月二十九日"},
+		{"泰昌元年八月初一日", "天命五年八月初一日"},
+		{"崇祯四年闰十一月初一日", "天聪五年闰十一月初一日"},
+		{"宣统三年十二月二十五日"},
+		{},
+		{"至正二十八年正月初一日", "洪武元年正月初一日"},
+		{"至正二十七年十二月三十日"},
+	}
+
+	for idx, each := range inputs {
+		actual := NewEraDates(each)
+		if len(actual) != len(expect[idx]) {
+			t.Fatalf("%+v should have %d era dates, got %d", each, len(expect[idx]), len(actual))
+		}
+		for i, d := range actual {
+			if d.String(true) != expect[idx][i] {
+				t.Fatalf("era date of %+v should be %s, got %s", each, expect[idx][i], d.String(true))
+			}
+			if d.LunarDate() != each {
+				t.Fatalf("lunar date of %s should be %+v, got %+v", d.String(true), each, d.LunarDate())
+			}
+		}
+	}
+
+	t.Run("test three kingdoms", func(t *testing.T) {
+		dates := NewEraDates(LunarDate{Year: 238, Month: 8, Day: 1})
+		expect := []string{"魏景初二年", "蜀汉延熙元年", "吴赤乌元年"}
+		if len(dates) != len(expect) {
+			t.Fatalf("238 should be in %d regimes, got %d", len(expect), len(dates))
+		}
+		for i, d := range dates {
+			actual := d.Era.Regime(true) + d.Era.Name(true) + chineseNumber(d.Year) + "年"
+			if d.Year == 1 {
+				actual = d.Era.Regime(true) + d.Era.Name(true) + "元年"
+			}
+			if actual != expect[i] {
+				t.Fatalf("the %dth era of 238 should be %s, got %s", i, expect[i], actual)
+			}
+		}
+	})
+
+	t.Run("test southern and northern dynasties", func(t *testing.T) {
+		inputs := []LunarDate{
+			{Year: 500, Month: 6, Day: 1},
+			{Year: 540, Month: 3, Day: 1},
+			{Year: 560, Month: 3, Day: 1},
+			{Year: 580, Month: 1, Day: 1},
+			{Year: 589, Month: 2, Day: 1},
+			{Year: 947, Month: 6, Day: 1},
+		}
+		expect := [][]string{
+			{"北魏景明元年六月初一日", "南齐永元二年六月初一日"},
+			{"梁大同六年三月初一日", "东魏兴和二年三月初一日", "西魏大统六年三月初一日"},
+			{"北齐乾明元年三月初一日", "陈天嘉元年三月初一日", "北周武成二年三月初一日"},
+			{"陈太建十二年正月初一日", "北周大象二年正月初一日"},
+			{"隋开皇九年二月初一日"},
+			{"辽大同元年六月初一日", "南汉乾和五年六月初一日", "后蜀广政十年六月初一日", "南唐保大五年六月初一日", "后汉天福十二年六月初一日"},
+		}
+
+		for idx, each := range inputs {
+			dates := NewEraDates(each)
+			if len(dates) != len(expect[idx]) {
+				t.Fatalf("%+v should have %d era dates, got %d", each, len(expect[idx]), len(dates))
+			}
+			for i, d := range dates {
+				if actual := d.Era.Regime(true) + d.String(true); actual != expect[idx][i] {
+					t.Fatalf("the %dth era date of %+v should be %s, got %s", i, each, expect[idx][i], actual)
+				}
+			}
+		}
+	})
+
+	t.Run("test year starts, leap months and gaps", func(t *testing.T) {
+		inputs := []LunarDate{
+			{Year: -104, Month: 9, Day: 1},
+			{Year: -104, Month: 10, Day: 1},
+			{Year: 237, Month: 12, Day: 1},
+			{Year: 694, Month: 11, Day: 1},
+			{Year: 700, Month: 11, Day: 1},
+			{Year: 760, Month: 4, Day: 30},
+			{Year: 760, Month: 4, Day: 1, IsLeapMonth: true},
+			{Year: 761, Month: 10, Day: 1},
+			{Year: 762, Month: 4, Day: 1},
+		}
+		expect := [][]string{
+			{"西汉元封六年九月初一日"},
+			{"西汉太初元年十月初一日"},
+			{"魏景初二年十二月初一日", "蜀汉建兴十五年十二月初一日", "吴嘉禾六年十二月初一日"},
+			{"武周证圣元年十一月初一日"},
+			{"武周久视元年十一月初一日"},
+			{"唐乾元三年四月三十日"},
+			{"唐上元元年闰四月初一日"},
+			{},
+			{"唐宝应元年四月初一日"},
+		}
+
+		for idx, each := range inputs {
+			dates := NewEraDates(each)
+			if len(dates) != len(expect[idx]) {
+				t.Fatalf("%+v should have %d era dates, got %d", each, len(expect[idx]), len(dates))
+			}
+			for i, d := range dates {
+				if actual := d.Era.Regime(true) + d.String(true); actual != expect[idx][i] {
+					t.Fatalf("the %dth era date of %+v should be %s, got %s", i, each, expect[idx][i], actual)
+				}
+				if d.LunarDate() != each {
+					t.Fatalf("lunar date of %s should be %+v, got %+v", d.String(true), each, d.LunarDate())
+				}
+			}
+		}
+	})
+
+	t.Run("test gregorian conversion", func(t *testing.T) {
+		ld := NewEraDates(LunarDate{Year: 1722, Month: 11, Day: 13})[0].LunarDate()
+		tm, ok := ld.Time()
+		if !ok || !tm.Equal(time.Date(1722, 12, 20, 0, 0, 0, 0, baseTimezone)) {
+			t.Fatalf("康熙六十一年十一月十三日 should be 1722-12-20, got %s", tm)
+		}
+	})
+}
+
+func TestParseEraDate(t *testing.T) {
+	inputs := []string{
+		"康熙六十一年十一月十三日",
+		"崇禎四年閏十一月初一日",
+		"洪武三十五年七月初一",
+		"洪武三十五年正月初一日",
+		"甘露元年六月十五日",
+		"宣统元年腊月廿一日",
+		"康熙七十年正月初一日",
+		"康熙六十一年十三月初一日",
+		"乾隆年正月初一日",
+		"贞观元年正月初一日",
+		"民国元年正月初一日",
+	}
+	expect := []struct {
+		Dates []LunarDate
+		Valid bool
+	}{
+		{[]LunarDate{{Year: 1722, Month: 11, Day: 13}}, true},
+		{[]LunarDate{{Year: 1631, Month: 11, Day: 1, IsLeapMonth: true}}, true},
+		{[]LunarDate{{Year: 1402, Month: 7, Day: 1}}, true},
+		{nil, true},
+		{[]LunarDate{{Year: -52, Month: 6, Day: 15}, {Year: 256, Month: 6, Day: 15}, {Year: 265, Month: 6, Day: 15}, {Year: 359, Month: 6, Day: 15}}, true},
+		{[]LunarDate{{Year: 1909, Month: 12, Day: 21}}, true},
+		{nil, true},
+		{nil, false},
+		{nil, false},
+		{[]LunarDate{{Year: 627, Month: 1, Day: 1}}, true},
+		{nil, false},
+	}
+
+	for idx, each := range inputs {
+		dates, valid := ParseEraDate(each)
+		if valid != expect[idx].Valid || len(dates) != len(expect[idx].Dates) {
+			t.Fatalf("parse %s should return %d dates and %v, got %d and %v", each, len(expect[idx].Dates), expect[idx].Valid, len(dates), valid)
+		}
+		for i, d := range dates {
+			if d.LunarDate() != expect[idx].Dates[i] {
+				t.Fatalf("parse %s should return %+v, got %+v", each, expect[idx].Dates[i], d.LunarDate())
+			}
+		}
+	}
+
+	t.Run("test era names sharing a prefix", func(t *testing.T) {
+		inputs := []string{"太平真君三年正月初一日", "太平二年正月初一日", "中大通元年十月初一日"}
+		expect := [][]string{{"北魏"}, {"吴", "北燕", "梁", "辽"}, {"梁"}}
+
+		for idx, each := range inputs {
+			dates, ok := ParseEraDate(each)
+			if !ok || len(dates) != len(expect[idx]) {
+				t.Fatalf("%s should match %d regimes, got %d and %v", each, len(expect[idx]), len(dates), ok)
+			}
+			for i, d := range dates {
+				if d.Era.Regime(true) != expect[idx][i] {
+					t.Fatalf("the %dth match of %s should belong to %s, got %s", i, each, expect[idx][i], d.Era.Regime(true))
+				}
+			}
+		}
+	})
+
+	t.Run("test ambiguous era names", func(t *testing.T) {
+		dates, _ := ParseEraDate("建兴二年正月初一日")
+		expect := []string{"蜀汉", "吴", "西晋", "成汉", "后燕"}
+		if len(dates) != len(expect) {
+			t.Fatalf("建兴二年 should match %d regimes, got %d", len(expect), len(dates))
+		}
+		for i, d := range dates {
+			if d.Era.Regime(true) != expect[i] || d.Era.Emperor(true) == "" {
+				t.Fatalf("the %dth 建兴 should belong to %s, got %s", i, expect[i], d.Era.Regime(true))
+			}
+		}
+	})
+}
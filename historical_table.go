@@ -0,0 +1,244 @@
+package calendar
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/solar"
+)
+
+// HistoricalTable 朔闰表, 逐年记录历史上实际颁行的农历的月份编排
+// 历代历书的朔日与闰月常与天文推算不同, 考证历史日期时应以朔闰表为准.
+// 注意: 本库目前只提供朔闰表的格式, 加载与校验, 并不附带任何朔闰表数据, 太初历(公元前104年)以来的历史月份编排尚未收录(见README中的尚未完成一节).
+// 在数据收录之前, 需自行从《二十史朔闰表》等文献录入, 再通过ParseHistoricalTable加载;
+// 未加载数据时, 1368年至1644年的日期只能按HistoricalLunarRule推算, 与当时颁行的历书可能相差一两日或闰月位置不同, 更早的日期则无法换算.
+// 表中各年只能按正月至十二月编排, 以建子, 建丑等月为岁首的年份(如王莽, 武周时期)暂不支持.
+// HistoricalTable实现了LunarRule, 可传给NewLunarDateFromTimeWithRule等函数,
+// 表中有记录的年份按表换算, 其余年份按fallback规则计算
+type HistoricalTable struct {
+	years    map[int]lunarYearInfo
+	fallback LunarRule
+}
+
+// NewHistoricalTable 以years中各农历年的月份编排创建朔闰表, fallback为nil时使用HistoricalLunarRule
+// 每年须按正月至十二月的顺序排列12个月, 或在其中某月之后加一个同名的闰月而为13个月, 各月首尾相接;
+// 表中各年还须与前后两年首尾相接, 前后两年不在表中时以fallback规则计算的结果为准, fallback不支持的年份不做检查. 不满足时返回错误
+func NewHistoricalTable(years []LunarYear, fallback LunarRule) (*HistoricalTable, error) {
+	if fallback == nil {
+		fallback = HistoricalLunarRule
+	}
+	table := &HistoricalTable{
+		years:    make(map[int]lunarYearInfo, len(years)),
+		fallback: fallback,
+	}
+
+	for _, ly := range years {
+		if _, exists := table.years[ly.Year]; exists {
+			return nil, fmt.Errorf("duplicate lunar year %d", ly.Year)
+		}
+		info, err := lunarYearInfoOf(ly)
+		if err != nil {
+			return nil, err
+		}
+		table.years[ly.Year] = info
+	}
+
+	// 表中各年须与前后两年首尾相接, 前后两年不在表中时按fallback规则计算
+	for _, ly := range years {
+		// fallback不支持的年份无从比较, 不做检查
+		info := table.years[ly.Year]
+		prev, ok := table.years[ly.Year-1]
+		if !ok {
+			prev, ok = lunarYearOf(ly.Year-1, fallback)
+		}
+		if ok && prev.end() != info.months[0].start {
+			return nil, fmt.Errorf("lunar year %d does not end right before lunar year %d", ly.Year-1, ly.Year)
+		}
+		if _, ok := table.years[ly.Year+1]; !ok {
+			if next, ok := lunarYearOf(ly.Year+1, fallback); ok && info.end() != next.months[0].start {
+				return nil, fmt.Errorf("lunar year %d does not end right before lunar year %d", ly.Year, ly.Year+1)
+			}
+		}
+	}
+
+	return table, nil
+}
+
+// ParseHistoricalTable 从r中读取朔闰表, fallback的含义与NewHistoricalTable相同
+// 每行记录一个农历年, 以空白分隔: 年份, 该年第一个月初一的公历日期, 以及按时间顺序排列的各月.
+// 年份采用天文纪年, 公元前104年记为-103; 日期格式为YYYY-MM-DD, 前缀J表示儒略历日期, 否则为(外推的)格里历日期;
+// 各月记为月份加大小, 闰月前加"闰". 空行与#开头的行被忽略. 例:
+//
+//	# 甲辰年
+//	2024 2024-02-10 1小 2大 3小 4小 5大 6小 7大 8大 9小 10大 11大 12小
+func ParseHistoricalTable(r io.Reader, fallback LunarRule) (*HistoricalTable, error) {
+	var years []LunarYear
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		ly, err := parseHistoricalTableLine(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		years = append(years, ly)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewHistoricalTable(years, fallback)
+}
+
+// Contains 朔闰表中是否有农历year年的记录
+func (t *HistoricalTable) Contains(year int) bool {
+	_, ok := t.years[year]
+	return ok
+}
+
+func (t *HistoricalTable) SolarTermTime(st solar.SolarTerm, year int) time.Time {
+	return t.fallback.SolarTermTime(st, year)
+}
+
+func (t *HistoricalTable) NewMoonAfter(tm time.Time) time.Time {
+	return t.fallback.NewMoonAfter(tm)
+}
+
+func (t *HistoricalTable) NewMoonBefore(tm time.Time) time.Time {
+	return t.fallback.NewMoonBefore(tm)
+}
+
+func (t *HistoricalTable) Location() *time.Location {
+	return t.fallback.Location()
+}
+
+func (t *HistoricalTable) ruleFor(year int) LunarRule {
+	return t.fallback
+}
+
+func (t *HistoricalTable) lunarYear(year int) (lunarYearInfo, bool) {
+	info, ok := t.years[year]
+	return info, ok
+}
+
+// lunarYearProvider 可直接给出农历年月份编排的LunarRule
+type lunarYearProvider interface {
+	lunarYear(year int) (lunarYearInfo, bool)
+}
+
+// lunarYearInfoOf 将LunarYear转换为lunarYearInfo, 并检查各月是否合法且首尾相接
+func lunarYearInfoOf(ly LunarYear) (lunarYearInfo, error) {
+	if len(ly.Months) != 12 && len(ly.Months) != 13 {
+		return lunarYearInfo{}, fmt.Errorf("lunar year %d should have 12 or 13 months, got %d", ly.Year, len(ly.Months))
+	}
+
+	info := lunarYearInfo{
+		year:   ly.Year,
+		months: make([]lunarMonthSpan, len(ly.Months)),
+	}
+	start := dayNumber(ly.Months[0].Start)
+	month, leaps := 0, 0
+	for i, m := range ly.Months {
+		if m.Days != 29 && m.Days != 30 {
+			return lunarYearInfo{}, fmt.Errorf("lunar year %d has invalid month %d with %d days", ly.Year, m.Month, m.Days)
+		}
+		// 平月依次为正月至十二月, 闰月紧随同名的平月之后且每年至多一个
+		if m.IsLeapMonth {
+			leaps++
+			if i == 0 || m.Month != month || leaps > 1 {
+				return lunarYearInfo{}, fmt.Errorf("lunar year %d has misplaced leap month %d", ly.Year, m.Month)
+			}
+		} else {
+			if m.Month != month+1 {
+				return lunarYearInfo{}, fmt.Errorf("month %d of lunar year %d is out of order", m.Month, ly.Year)
+			}
+			month++
+		}
+		if !m.Start.IsZero() && dayNumber(m.Start) != start {
+			return lunarYearInfo{}, fmt.Errorf("month %d of lunar year %d should start at %s", m.Month, ly.Year, dayTime(start))
+		}
+		info.months[i] = lunarMonthSpan{
+			start: start,
+			days:  m.Days,
+			month: m.Month,
+			leap:  m.IsLeapMonth,
+		}
+		start += int64(m.Days)
+	}
+	if month != 12 {
+		return lunarYearInfo{}, fmt.Errorf("lunar year %d should end with month 12, got %d", ly.Year, month)
+	}
+	return info, nil
+}
+
+// parseHistoricalTableLine 解析朔闰表中的一行, 格式见ParseHistoricalTable
+func parseHistoricalTableLine(text string) (LunarYear, error) {
+	fields := strings.Fields(text)
+	if len(fields) < 3 {
+		return LunarYear{}, fmt.Errorf("expect year, date and months, got %q", text)
+	}
+
+	year, err := strconv.Atoi(fields[0])
+	if err != nil {
+		return LunarYear{}, fmt.Errorf("invalid year %q", fields[0])
+	}
+	day, err := parseHistoricalDate(fields[1])
+	if err != nil {
+		return LunarYear{}, err
+	}
+
+	ly := LunarYear{Year: year}
+	for _, field := range fields[2:] {
+		m := LunarMonth{Start: dayTime(day)}
+		word := field
+		if strings.HasPrefix(word, "闰") {
+			m.IsLeapMonth = true
+			word = strings.TrimPrefix(word, "闰")
+		}
+		switch {
+		case strings.HasSuffix(word, "大"):
+			m.Days = 30
+		case strings.HasSuffix(word, "小"):
+			m.Days = 29
+		default:
+			return LunarYear{}, fmt.Errorf("invalid month %q", field)
+		}
+		if m.Month, err = strconv.Atoi(strings.TrimRight(word, "大小")); err != nil {
+			return LunarYear{}, fmt.Errorf("invalid month %q", field)
+		}
+		ly.Months = append(ly.Months, m)
+		day += int64(m.Days)
+	}
+
+	return ly, nil
+}
+
+// parseHistoricalDate 解析YYYY-MM-DD格式的日期并返回其日序号, 前缀J表示儒略历日期
+func parseHistoricalDate(text string) (int64, error) {
+	julian := strings.HasPrefix(text, "J")
+	var y, m, d int
+	if _, err := fmt.Sscanf(strings.TrimPrefix(text, "J"), "%d-%d-%d", &y, &m, &d); err != nil || m < 1 || m > 12 || d < 1 || d > 31 {
+		return 0, fmt.Errorf("invalid date %q", text)
+	}
+
+	if julian {
+		return julianCalendarDayNumber(y, m, d), nil
+	}
+	return floorDiv(time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC).Unix(), 24*60*60), nil
+}
+
+// julianCalendarDayNumber 返回儒略历y年m月d日的日序号, 1970年1月1日(格里历)为0
+func julianCalendarDayNumber(y, m, d int) int64 {
+	a := (14 - m) / 12
+	yy := int64(y + 4800 - a)
+	mm := int64(m + 12*a - 3)
+	// 儒略日数(JDN), 2440588为1970年1月1日的儒略日数
+	jdn := int64(d) + (153*mm+2)/5 + 365*yy + floorDiv(yy, 4) - 32083
+	return jdn - 2440588
+}
@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// formatHistoricalTableLine 按ParseHistoricalTable的格式输出一个农历年
+func formatHistoricalTableLine(ly LunarYear) string {
+	line := fmt.Sprintf("%d %s", ly.Year, ly.Months[0].Start.Format("2006-01-02"))
+	for _, m := range ly.Months {
+		leap, size := "", "小"
+		if m.IsLeapMonth {
+			leap = "闰"
+		}
+		if m.IsBig() {
+			size = "大"
+		}
+		line += fmt.Sprintf(" %s%d%s", leap, m.Month, size)
+	}
+	return line
+}
+
+func TestParseHistoricalTable(t *testing.T) {
+	t.Run("test conversion", func(t *testing.T) {
+		// 以平气规则推算的1631年至1632年作为表中数据, 以现行规则作为表外的规则
+		y1631, _ := NewLunarYearWithRule(1631, MeanSolarTermLunarRule)
+		y1632, _ := NewLunarYearWithRule(1632, MeanSolarTermLunarRule)
+		text := strings.Join([]string{
+			"# comment",
+			formatHistoricalTableLine(y1631),
+			"",
+			formatHistoricalTableLine(y1632),
+		}, "\n")
+		table, err := ParseHistoricalTable(strings.NewReader(text), ModernLunarRule)
+		if err != nil {
+			t.Fatalf("parse historical table failed: %v", err)
+		}
+		if !table.Contains(1631) || !table.Contains(1632) || table.Contains(1633) {
+			t.Fatalf("historical table should contain exactly 1631 and 1632")
+		}
+
+		inputs := []int{1631, 1632, 1633}
+		expect := []int{11, 0, NewLunarYear(1633).LeapMonth()}
+		for idx, year := range inputs {
+			if actual, ok := NewLunarYearWithRule(year, table); !ok || actual.LeapMonth() != expect[idx] {
+				t.Fatalf("leap month of %d should be %d, got %d", year, expect[idx], actual.LeapMonth())
+			}
+		}
+
+		d := LunarDate{Year: 1631, Month: 11, Day: 15, IsLeapMonth: true}
+		tm, ok := d.TimeWithRule(table)
+		if !ok {
+			t.Fatalf("%+v should exist in historical table", d)
+		}
+		if actual, ok := NewLunarDateFromTimeWithRule(tm, table); !ok || actual != d {
+			t.Fatalf("lunar date of %s should be %+v, got %+v", tm, d, actual)
+		}
+	})
+
+	t.Run("test julian dates", func(t *testing.T) {
+		inputs := []string{"J1582-10-04", "J-103-01-01", "J2000-01-01"}
+		expect := []time.Time{
+			time.Date(1582, 10, 14, 0, 0, 0, 0, time.UTC),
+			time.Date(-104, 12, 29, 0, 0, 0, 0, time.UTC),
+			time.Date(2000, 1, 14, 0, 0, 0, 0, time.UTC),
+		}
+
+		for idx, each := range inputs {
+			day, err := parseHistoricalDate(each)
+			if err != nil {
+				t.Fatalf("parse %s failed: %v", each, err)
+			}
+			if actual := time.Unix(day*24*60*60, 0).UTC(); !actual.Equal(expect[idx]) {
+				t.Fatalf("%s should be %s, got %s", each, expect[idx], actual)
+			}
+		}
+	})
+
+	t.Run("test invalid tables", func(t *testing.T) {
+		y2024 := formatHistoricalTableLine(NewLunarYear(2024))
+		// 1500年的各月整体推后两天, 与按fallback规则计算的1499年之间出现空缺
+		y1500, _ := NewLunarYearWithRule(1500, HistoricalLunarRule)
+		y1500.Months[0].Start = y1500.Months[0].Start.AddDate(0, 0, 2)
+		inputs := []string{
+			"2024 2024-02-10",
+			"2024 2024-13-10 1小",
+			"2024 2024-02-10 1中",
+			"2024 2024-02-10 13大",
+			"2024 2024-02-10 1大",
+			strings.Replace(y2024, " 12小", "", 1),
+			strings.Replace(y2024, "2大 3小", "3小 2大", 1),
+			strings.Replace(y2024, "12小", "12小 闰12小", 1),
+			strings.Replace(y2024, "1小", "闰1小", 1),
+			y2024 + "\n" + y2024,
+			formatHistoricalTableLine(NewLunarYear(2023)) + "\n" + strings.Replace(y2024, "2024-02-10", "2024-02-11", 1),
+			strings.Replace(y2024, "2024-02-10", "2024-02-09", 1),
+			formatHistoricalTableLine(y1500),
+		}
+
+		for _, each := range inputs {
+			if _, err := ParseHistoricalTable(strings.NewReader(each), ModernLunarRule); err == nil {
+				t.Fatalf("parse %q should fail", each)
+			}
+		}
+		if _, err := ParseHistoricalTable(strings.NewReader(y2024), ModernLunarRule); err != nil {
+			t.Fatalf("parse %q failed: %v", y2024, err)
+		}
+	})
+
+	t.Run("test dates outside the table", func(t *testing.T) {
+		// 绕过NewHistoricalTable的检查, 构造与1499年之间有空缺的表
+		info, _ := lunarYearOf(1500, MeanSolarTermLunarRule)
+		info.months = info.months[1:]
+		table := &HistoricalTable{
+			years:    map[int]lunarYearInfo{1500: info},
+			fallback: MeanSolarTermLunarRule,
+		}
+		tm := dayTimeIn(info.months[0].start-1, table.Location())
+		if d, ok := NewLunarDateFromTimeWithRule(tm, table); ok {
+			t.Fatalf("%s is not in any lunar year, got %+v", tm, d)
+		}
+	})
+}
@@ -0,0 +1,109 @@
+// Package astro 提供天文计算共用的儒略日与力学时换算
+package astro
+
+import (
+	"math"
+	"time"
+)
+
+// J2000 J2000.0历元的儒略日
+const J2000 = 2451545.0
+
+// unixEpoch 1970-01-01T00:00:00Z的儒略日
+const unixEpoch = 2440587.5
+
+// JulianDay 返回t对应的儒略日(世界时)
+func JulianDay(t time.Time) float64 {
+	return float64(t.Unix())/86400 + float64(t.Nanosecond())/86400e9 + unixEpoch
+}
+
+// TimeFromJulianDay 将儒略日(世界时)转换为UTC时间, 精确到毫秒
+func TimeFromJulianDay(jd float64) time.Time {
+	ms := math.Floor((jd-unixEpoch)*86400e3 + 0.5)
+	sec := math.Floor(ms / 1000)
+	return time.Unix(int64(sec), int64(ms-sec*1000)*int64(time.Millisecond)).UTC()
+}
+
+// JulianEphemerisDay 返回t对应的儒略历书日(力学时)
+func JulianEphemerisDay(t time.Time) float64 {
+	jd := JulianDay(t)
+	return jd + DeltaT(jd)/86400
+}
+
+// TimeFromJulianEphemerisDay 将儒略历书日(力学时)转换为UTC时间
+func TimeFromJulianEphemerisDay(jde float64) time.Time {
+	// ΔT变化缓慢, 用jde近似jd求ΔT引入的误差可以忽略
+	return TimeFromJulianDay(jde - DeltaT(jde)/86400)
+}
+
+// DeltaT 返回儒略日jd时的ΔT = TT - UT, 单位秒
+// 采用Espenak与Meeus给出的分段多项式
+func DeltaT(jd float64) float64 {
+	y := 2000 + (jd-J2000)/365.2425
+
+	switch {
+	case y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return Poly(u, 10583.6, -1014.41, 33.78311, -5.952053, -0.1798452, 0.022174192, 0.0090316521)
+	case y < 1600:
+		u := (y - 1000) / 100
+		return Poly(u, 1574.2, -556.01, 71.23472, 0.319781, -0.8503463, -0.005050998, 0.0083572073)
+	case y < 1700:
+		u := y - 1600
+		return Poly(u, 120, -0.9808, -0.01532, 1.0/7129)
+	case y < 1800:
+		u := y - 1700
+		return Poly(u, 8.83, 0.1603, -0.0059285, 0.00013336, -1.0/1174000)
+	case y < 1860:
+		u := y - 1800
+		return Poly(u, 13.72, -0.332447, 0.0068612, 0.0041116, -0.00037436, 0.0000121272, -0.0000001699, 0.000000000875)
+	case y < 1900:
+		u := y - 1860
+		return Poly(u, 7.62, 0.5737, -0.251754, 0.01680668, -0.0004473624, 1.0/233174)
+	case y < 1920:
+		u := y - 1900
+		return Poly(u, -2.79, 1.494119, -0.0598939, 0.0061966, -0.000197)
+	case y < 1941:
+		u := y - 1920
+		return Poly(u, 21.20, 0.84493, -0.076100, 0.0020936)
+	case y < 1961:
+		u := y - 1950
+		return Poly(u, 29.07, 0.407, -1.0/233, 1.0/2547)
+	case y < 1986:
+		u := y - 1975
+		return Poly(u, 45.45, 1.067, -1.0/260, -1.0/718)
+	case y < 2005:
+		u := y - 2000
+		return Poly(u, 63.86, 0.3345, -0.060374, 0.0017275, 0.000651814, 0.00002373599)
+	case y < 2050:
+		u := y - 2000
+		return Poly(u, 62.92, 0.32217, 0.005589)
+	case y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	}
+}
+
+// Poly 按升幂计算多项式 c[0] + c[1]x + c[2]x² + ...
+func Poly(x float64, c ...float64) float64 {
+	r := 0.0
+	for i := len(c) - 1; i >= 0; i-- {
+		r = r*x + c[i]
+	}
+	return r
+}
+
+// NormalizeDegrees 将角度规约到[0, 360)
+func NormalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
@@ -0,0 +1,71 @@
+// lunartable 按天文算法计算1900年至2100年的农历月份编排, 生成预计算表lunar_table.go
+// 用法: go generate github.com/hsldymq/go-chinese-calendar
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/lunisolar"
+)
+
+const (
+	firstYear = 1900
+	lastYear  = 2100
+)
+
+func main() {
+	output := flag.String("o", "lunar_table.go", "output file")
+	flag.Parse()
+
+	buf := &bytes.Buffer{}
+	fmt.Fprintf(buf, "// Code generated by go run ./internal/gen/lunartable; DO NOT EDIT.\n\n")
+	fmt.Fprintf(buf, "package calendar\n\n")
+	fmt.Fprintf(buf, "// lunarTableFirstYear 预计算表的起始年份\n")
+	fmt.Fprintf(buf, "const lunarTableFirstYear = %d\n\n", firstYear)
+	fmt.Fprintf(buf, "// lunarTable 农历年份预计算表, 编码方式见lunarYearFromTable\n")
+	fmt.Fprintf(buf, "var lunarTable = []uint32{\n")
+	for year := firstYear; year <= lastYear; year++ {
+		fmt.Fprintf(buf, "0x%06x, // %d\n", encode(year, lunisolar.YearMonths(year, modernRule)), year)
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatalf("format source: %v", err)
+	}
+	if err := ioutil.WriteFile(*output, src, 0644); err != nil {
+		log.Fatalf("write %s: %v", *output, err)
+	}
+}
+
+// modernRule 各岁均采用现行规则
+func modernRule(int) lunisolar.Rule {
+	return lunisolar.ModernRule{}
+}
+
+// encode 按lunarYearFromTable中描述的方式将农历year年的各月编码为uint32
+func encode(year int, months []lunisolar.MonthSpan) uint32 {
+	var v uint32
+	for i, m := range months {
+		if m.Leap {
+			v |= uint32(m.Month)
+		}
+		if m.Days == 30 {
+			v |= 1 << (4 + uint(i))
+		}
+	}
+
+	start := lunisolar.DayTimeIn(months[0].Start, lunisolar.BaseTimezone)
+	newYear := time.Date(start.Year(), 1, 1, 0, 0, 0, 0, start.Location())
+	offset := int(start.Sub(newYear).Hours() / 24)
+	if offset < 0 || offset >= 1<<6 {
+		log.Fatalf("lunar year %d begins at %s, which cannot be encoded", year, start)
+	}
+	return v | uint32(offset)<<17
+}
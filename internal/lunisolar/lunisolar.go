@@ -0,0 +1,153 @@
+// Package lunisolar 提供农历月份编排的天文推算, 供根包与预计算表生成器共用
+package lunisolar
+
+import (
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/lunar"
+	"github.com/hsldymq/go-chinese-calendar/solar"
+)
+
+// BaseTimezone 农历计算的标准时区, 为东经120°标准时
+// 这里没有通过loadLocation载入Asia/Shanghai, 而是用FixedZone来固定这个8小时的时差(尽管当前这两者当前是等同的)
+var BaseTimezone = time.FixedZone("UTF+8", 8*60*60)
+
+// Rule 农历的编排规则, 方法含义与根包的LunarRule相同
+type Rule interface {
+	SolarTermTime(st solar.SolarTerm, year int) time.Time
+	NewMoonAfter(t time.Time) time.Time
+	NewMoonBefore(t time.Time) time.Time
+	Location() *time.Location
+}
+
+// ModernRule 现行农历规则(GB/T 33661-2017): 定气定朔, 以东经120°标准时划分日期
+type ModernRule struct{}
+
+func (ModernRule) SolarTermTime(st solar.SolarTerm, year int) time.Time {
+	return st.Time(year)
+}
+
+func (ModernRule) NewMoonAfter(t time.Time) time.Time {
+	return lunar.PhaseEnum.NewMoon.Next(t)
+}
+
+func (ModernRule) NewMoonBefore(t time.Time) time.Time {
+	return lunar.PhaseEnum.NewMoon.Previous(t)
+}
+
+func (ModernRule) Location() *time.Location {
+	return BaseTimezone
+}
+
+// MonthSpan 农历月在日序号上的跨度
+type MonthSpan struct {
+	// Start 初一的日序号
+	Start int64
+	// Days 该月天数
+	Days int
+	// Month 月份, 1-12
+	Month int
+	// Leap 是否为闰月
+	Leap bool
+}
+
+// YearMonths 返回农历year年的各月, ruleFor返回各岁所用的规则
+// 农历year年的正月至十月(及其间的闰月)属于year-1年冬至到year年冬至之间的岁,
+// 十一月与十二月(及其间的闰月)属于下一岁
+func YearMonths(year int, ruleFor func(sui int) Rule) []MonthSpan {
+	var months []MonthSpan
+	for _, m := range SuiMonths(year, ruleFor(year)) {
+		if m.Month <= 10 {
+			months = append(months, m)
+		}
+	}
+	for _, m := range SuiMonths(year+1, ruleFor(year+1)) {
+		if m.Month >= 11 {
+			months = append(months, m)
+		}
+	}
+	return months
+}
+
+// SuiMonths 按rule规则计算公历year-1年冬至至year年冬至这一岁中的各月
+// 以含冬至的月为十一月, 若两个十一月之间有13个月, 则其中第一个不含中气的月为闰月, 月份与上一月相同
+func SuiMonths(year int, rule Rule) []MonthSpan {
+	loc := rule.Location()
+	winterSolstice := solar.SolarTermEnum.TheWinterSolstice
+	fromDay := DayNumberIn(rule.SolarTermTime(winterSolstice, year-1), loc)
+	toDay := DayNumberIn(rule.SolarTermTime(winterSolstice, year), loc)
+
+	// 冬至当日或之前最近的朔日为十一月初一
+	newMoon := rule.NewMoonBefore(DayTimeIn(fromDay+1, loc).Add(-time.Nanosecond))
+	starts := []int64{DayNumberIn(newMoon, loc)}
+	for {
+		newMoon = rule.NewMoonAfter(newMoon.Add(24 * time.Hour))
+		day := DayNumberIn(newMoon, loc)
+		if day > toDay {
+			break
+		}
+		starts = append(starts, day)
+	}
+	count := len(starts) - 1
+
+	leapIdx := -1
+	if count == 13 {
+		// 两个冬至之间的中气: 大寒, 雨水, 春分, ..., 小雪
+		var midTerms []int64
+		for st := solar.SolarTermEnum.GreaterCold; st != winterSolstice; st = st.Move(2) {
+			midTerms = append(midTerms, DayNumberIn(rule.SolarTermTime(st, year), loc))
+		}
+		for i := 1; i < count && leapIdx < 0; i++ {
+			if !containsDay(midTerms, starts[i], starts[i+1]) {
+				leapIdx = i
+			}
+		}
+	}
+
+	months := make([]MonthSpan, count)
+	month := 10
+	for i := range months {
+		months[i] = MonthSpan{
+			Start: starts[i],
+			Days:  int(starts[i+1] - starts[i]),
+			Leap:  i == leapIdx,
+		}
+		if !months[i].Leap {
+			month = month%12 + 1
+		}
+		months[i].Month = month
+	}
+
+	return months
+}
+
+// DayNumberIn 返回t在loc时区下所处日期的日序号, 1970年1月1日为0
+func DayNumberIn(t time.Time, loc *time.Location) int64 {
+	_, offset := t.In(loc).Zone()
+	return FloorDiv(t.Unix()+int64(offset), 24*60*60)
+}
+
+// DayTimeIn 返回日序号对应日期在loc时区下的零点
+func DayTimeIn(day int64, loc *time.Location) time.Time {
+	_, offset := time.Unix(0, 0).In(loc).Zone()
+	return time.Unix(day*24*60*60-int64(offset), 0).In(loc)
+}
+
+// FloorDiv 向下取整的整数除法
+func FloorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
+}
+
+// containsDay days中是否有落在[from, to)之间的日序号
+func containsDay(days []int64, from, to int64) bool {
+	for _, d := range days {
+		if d >= from && d < to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package lunar
+
+import (
+	"math"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// 以下算法取自Jean Meeus《Astronomical Algorithms》第49章
+// 在现代的误差不超过数十秒, 公元1000至3000年间主要受ΔT的不确定性影响
+
+// newMoonTerms 朔的周期项系数, 依次对应newMoonArgs中的各个幅角
+var newMoonTerms = []float64{
+	-0.40720, 0.17241, 0.01608, 0.01039, 0.00739, -0.00514, 0.00208, -0.00111, -0.00057, 0.00056, -0.00042, 0.00042, 0.00038,
+	-0.00024, -0.00017, -0.00007, 0.00004, 0.00004, 0.00003, 0.00003, -0.00003, 0.00003, -0.00002, -0.00002, 0.00002,
+}
+
+// fullMoonTerms 望的周期项系数, 幅角与朔相同
+var fullMoonTerms = []float64{
+	-0.40614, 0.17302, 0.01614, 0.01043, 0.00734, -0.00515, 0.00209, -0.00111, -0.00057, 0.00056, -0.00042, 0.00042, 0.00038,
+	-0.00024, -0.00017, -0.00007, 0.00004, 0.00004, 0.00003, 0.00003, -0.00003, 0.00003, -0.00002, -0.00002, 0.00002,
+}
+
+// quarterTerms 上弦与下弦的周期项系数, 依次对应quarterArgs中的各个幅角
+var quarterTerms = []float64{
+	-0.62801, 0.17172, -0.01183, 0.00862, 0.00804, 0.00454, 0.00204, -0.00180, -0.00070, -0.00040, -0.00034, 0.00032, 0.00032,
+	-0.00028, 0.00027, -0.00017, -0.00005, 0.00004, -0.00004, 0.00004, 0.00003, 0.00003, 0.00002, 0.00002, -0.00002,
+}
+
+// planetaryTerms 行星摄动项的振幅
+var planetaryTerms = []float64{
+	0.000325, 0.000165, 0.000164, 0.000126, 0.000110, 0.000062, 0.000060,
+	0.000056, 0.000047, 0.000042, 0.000040, 0.000037, 0.000035, 0.000023,
+}
+
+// phaseJDE 返回朔望月序数k对应月相的儒略历书日
+func phaseJDE(k float64) float64 {
+	t := k / 1236.85
+	jde := astro.Poly(t, 0, 0, 0.00015437, -0.000000150, 0.00000000073) + 2451550.09766 + synodicMonth*k
+
+	rad := math.Pi / 180
+	e := astro.Poly(t, 1, -0.002516, -0.0000074)
+	m := (2.5534 + 29.10535670*k + astro.Poly(t, 0, 0, -0.0000014, -0.00000011)) * rad
+	mp := (201.5643 + 385.81693528*k + astro.Poly(t, 0, 0, 0.0107582, 0.00001238, -0.000000058)) * rad
+	f := (160.7108 + 390.67050284*k + astro.Poly(t, 0, 0, -0.0016118, -0.00000227, 0.000000011)) * rad
+	omega := (124.7746 - 1.56375588*k + astro.Poly(t, 0, 0, 0.0020672, 0.00000215)) * rad
+
+	switch frac := k - math.Floor(k); {
+	case frac < 0.125 || frac >= 0.875:
+		jde += sumTerms(newMoonTerms, newMoonArgs(e, m, mp, f, omega))
+	case frac >= 0.375 && frac < 0.625:
+		jde += sumTerms(fullMoonTerms, newMoonArgs(e, m, mp, f, omega))
+	default:
+		jde += sumTerms(quarterTerms, quarterArgs(e, m, mp, f, omega))
+		w := 0.00306 - 0.00038*e*math.Cos(m) + 0.00026*math.Cos(mp) -
+			0.00002*math.Cos(mp-m) + 0.00002*math.Cos(mp+m) + 0.00002*math.Cos(2*f)
+		if frac < 0.5 {
+			jde += w
+		} else {
+			jde -= w
+		}
+	}
+
+	a := []float64{
+		299.77 + 0.107408*k - 0.009173*t*t,
+		251.88 + 0.016321*k,
+		251.83 + 26.651886*k,
+		349.42 + 36.412478*k,
+		84.66 + 18.206239*k,
+		141.74 + 53.303771*k,
+		207.14 + 2.453732*k,
+		154.84 + 7.306860*k,
+		34.52 + 27.261239*k,
+		207.19 + 0.121824*k,
+		291.34 + 1.844379*k,
+		161.72 + 24.198154*k,
+		239.56 + 25.513099*k,
+		331.55 + 3.592518*k,
+	}
+	for i, amplitude := range planetaryTerms {
+		jde += amplitude * math.Sin(a[i]*rad)
+	}
+
+	return jde
+}
+
+// newMoonArgs 朔与望的周期项幅角, 已乘以对应的地球轨道偏心率因子E
+func newMoonArgs(e, m, mp, f, omega float64) []float64 {
+	return []float64{
+		math.Sin(mp),
+		e * math.Sin(m),
+		math.Sin(2 * mp),
+		math.Sin(2 * f),
+		e * math.Sin(mp-m),
+		e * math.Sin(mp+m),
+		e * e * math.Sin(2*m),
+		math.Sin(mp - 2*f),
+		math.Sin(mp + 2*f),
+		e * math.Sin(2*mp+m),
+		math.Sin(3 * mp),
+		e * math.Sin(m+2*f),
+		e * math.Sin(m-2*f),
+		e * math.Sin(2*mp-m),
+		math.Sin(omega),
+		math.Sin(mp + 2*m),
+		math.Sin(2*mp - 2*f),
+		math.Sin(3 * m),
+		math.Sin(mp + m - 2*f),
+		math.Sin(2*mp + 2*f),
+		math.Sin(mp + m + 2*f),
+		math.Sin(mp - m + 2*f),
+		math.Sin(mp - m - 2*f),
+		math.Sin(3*mp + m),
+		math.Sin(4 * mp),
+	}
+}
+
+// quarterArgs 上弦与下弦的周期项幅角, 已乘以对应的地球轨道偏心率因子E
+func quarterArgs(e, m, mp, f, omega float64) []float64 {
+	return []float64{
+		math.Sin(mp),
+		e * math.Sin(m),
+		e * math.Sin(mp+m),
+		math.Sin(2 * mp),
+		math.Sin(2 * f),
+		e * math.Sin(mp-m),
+		e * e * math.Sin(2*m),
+		math.Sin(mp - 2*f),
+		math.Sin(mp + 2*f),
+		math.Sin(3 * mp),
+		e * math.Sin(2*mp-m),
+		e * math.Sin(m+2*f),
+		e * math.Sin(m-2*f),
+		e * e * math.Sin(mp+2*m),
+		e * math.Sin(2*mp+m),
+		math.Sin(omega),
+		math.Sin(mp - m - 2*f),
+		math.Sin(2*mp + 2*f),
+		math.Sin(mp + m + 2*f),
+		math.Sin(mp - 2*m),
+		math.Sin(mp + m - 2*f),
+		math.Sin(3 * m),
+		math.Sin(2*mp - 2*f),
+		math.Sin(mp - m + 2*f),
+		math.Sin(3*mp + m),
+	}
+}
+
+func sumTerms(coefficients, args []float64) float64 {
+	sum := 0.0
+	for i, c := range coefficients {
+		sum += c * args[i]
+	}
+	return sum
+}
@@ -0,0 +1,87 @@
+// Package lunar 提供月相(朔, 上弦, 望, 下弦)的计算
+package lunar
+
+import (
+	"math"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// synodicMonth 朔望月平均长度, 单位日
+const synodicMonth = 29.530588861
+
+// epsilon 时刻比较的容差(1毫秒), 抵消时间与儒略日互相转换时的舍入误差
+const epsilon = 0.001 / 86400
+
+// phaseWords 月相中文
+var phaseWords = [4]string{"朔", "上弦", "望", "下弦"}
+
+// Phase 月相
+type Phase int
+
+// Next 返回t之后(含t)第一次出现该月相的时刻(UTC)
+// 例: PhaseEnum.NewMoon.Next(2024-02-01) -> 2024-02-09 22:59 UTC, 即北京时间2024年2月10日甲辰年正月初一
+func (p Phase) Next(t time.Time) time.Time {
+	if !p.IsValid() {
+		return time.Time{}
+	}
+
+	target := astro.JulianEphemerisDay(t)
+	k := p.lunationAround(target)
+	for phaseJDE(k) < target-epsilon {
+		k++
+	}
+	for phaseJDE(k-1) >= target-epsilon {
+		k--
+	}
+	return astro.TimeFromJulianEphemerisDay(phaseJDE(k))
+}
+
+// Previous 返回t之前(含t)最近一次出现该月相的时刻(UTC)
+func (p Phase) Previous(t time.Time) time.Time {
+	if !p.IsValid() {
+		return time.Time{}
+	}
+
+	target := astro.JulianEphemerisDay(t)
+	k := p.lunationAround(target)
+	for phaseJDE(k) > target+epsilon {
+		k--
+	}
+	for phaseJDE(k+1) <= target+epsilon {
+		k++
+	}
+	return astro.TimeFromJulianEphemerisDay(phaseJDE(k))
+}
+
+// String 返回月相中文
+func (p Phase) String() string {
+	if !p.IsValid() {
+		return ""
+	}
+	return phaseWords[p]
+}
+
+func (p Phase) IsValid() bool {
+	return p >= 0 && p < 4
+}
+
+// lunationAround 返回儒略历书日jde附近该月相的朔望月序数k
+// k为整数时表示朔, 加上0.25, 0.5, 0.75分别表示上弦, 望, 下弦; k=0为2000年1月6日的朔
+func (p Phase) lunationAround(jde float64) float64 {
+	return math.Floor((jde-2451550.09766)/synodicMonth) + float64(p)/4
+}
+
+// PhaseEnum 月相枚举项
+var PhaseEnum = struct {
+	NewMoon      Phase // 朔
+	FirstQuarter Phase // 上弦
+	FullMoon     Phase // 望
+	LastQuarter  Phase // 下弦
+}{
+	NewMoon:      0,
+	FirstQuarter: 1,
+	FullMoon:     2,
+	LastQuarter:  3,
+}
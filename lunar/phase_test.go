@@ -0,0 +1,79 @@
+package lunar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPhaseJDE(t *testing.T) {
+	// Meeus例49.a与例49.b
+	inputs := []float64{-283, 544.75}
+	expect := []float64{2443192.65118, 2467636.49186}
+
+	for idx, k := range inputs {
+		actual := phaseJDE(k)
+		if math.Abs(actual-expect[idx]) > 0.00001 {
+			t.Fatalf("JDE of lunation %.2f should be %.5f, got %.5f", k, expect[idx], actual)
+		}
+	}
+}
+
+func TestPhase(t *testing.T) {
+	t.Run("test Next method", func(t *testing.T) {
+		inputs := []struct {
+			Phase
+			time.Time
+		}{
+			{PhaseEnum.NewMoon, time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{PhaseEnum.NewMoon, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+			{PhaseEnum.FullMoon, time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+			{PhaseEnum.NewMoon, time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)},
+		}
+		expect := []time.Time{
+			time.Date(2000, 1, 6, 18, 14, 0, 0, time.UTC),
+			time.Date(2024, 2, 9, 22, 59, 0, 0, time.UTC),
+			time.Date(2024, 9, 18, 2, 34, 0, 0, time.UTC),
+			time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC),
+		}
+
+		for idx, each := range inputs {
+			actual := each.Phase.Next(each.Time)
+			if diff := actual.Sub(expect[idx]); diff < -time.Minute || diff > time.Minute {
+				t.Fatalf("next %s after %s should be at %s, got %s", each.Phase, each.Time, expect[idx], actual)
+			}
+		}
+	})
+
+	t.Run("test Previous method", func(t *testing.T) {
+		from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		for p := PhaseEnum.NewMoon; p <= PhaseEnum.LastQuarter; p++ {
+			prev := p.Previous(from)
+			next := p.Next(from)
+			if prev.After(from) || next.Before(from) {
+				t.Fatalf("%s should be surrounded by %s and %s", from, prev, next)
+			}
+			if gap := next.Sub(prev).Hours() / 24; gap < 29.1 || gap > 30 {
+				t.Fatalf("%s and %s should be one synodic month apart, got %.2f days", prev, next, gap)
+			}
+			if again := p.Previous(next); !again.Equal(next) {
+				t.Fatalf("previous %s at %s should be itself, got %s", p, next, again)
+			}
+			if again := p.Next(prev); !again.Equal(prev) {
+				t.Fatalf("next %s at %s should be itself, got %s", p, prev, again)
+			}
+		}
+	})
+
+	t.Run("test phase order", func(t *testing.T) {
+		newMoon := PhaseEnum.NewMoon.Next(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+		prev := newMoon
+		for _, p := range []Phase{PhaseEnum.FirstQuarter, PhaseEnum.FullMoon, PhaseEnum.LastQuarter, PhaseEnum.NewMoon} {
+			actual := p.Next(prev.Add(time.Second))
+			if gap := actual.Sub(prev).Hours() / 24; gap < 6.5 || gap > 8.5 {
+				t.Fatalf("%s should follow %s by about 7 days, got %.2f days", p, prev, gap)
+			}
+			prev = actual
+		}
+	})
+}
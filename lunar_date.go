@@ -0,0 +1,141 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/sexagenary"
+)
+
+// lunarMonthWords 农历月份中文简体
+var lunarMonthWords = [12]string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "腊"}
+
+// lunarMonthWordsTraditional 农历月份中文繁体
+var lunarMonthWordsTraditional = [12]string{"正", "二", "三", "四", "五", "六", "七", "八", "九", "十", "冬", "臘"}
+
+// lunarDayWords 农历日中文
+var lunarDayWords = [30]string{
+	"初一", "初二", "初三", "初四", "初五", "初六", "初七", "初八", "初九", "初十",
+	"十一", "十二", "十三", "十四", "十五", "十六", "十七", "十八", "十九", "二十",
+	"廿一", "廿二", "廿三", "廿四", "廿五", "廿六", "廿七", "廿八", "廿九", "三十",
+}
+
+// LunarDate 农历日期
+// 采用现行的无中置闰规则: 以朔日为月首, 以含冬至的月为十一月,
+// 冬至到下一个冬至之间有13个月时, 以其中第一个不含中气的月为闰月
+type LunarDate struct {
+	// Year 农历年, 以正月初一所在的公历年份表示
+	Year int
+	// Month 月份, 1-12
+	Month int
+	// Day 日, 1-30
+	Day int
+	// IsLeapMonth 是否为闰月
+	IsLeapMonth bool
+}
+
+// NewLunarDateFromTime 返回t在东经120°标准时下所处的农历日期
+func NewLunarDateFromTime(t time.Time) LunarDate {
+	// 现行规则下各农历年首尾相接, 总能找到t所处的日期
+	d, _ := NewLunarDateFromTimeWithRule(t, ModernLunarRule)
+	return d
+}
+
+// NewLunarDateFromTimeWithRule 按rule规则返回t所处的农历日期, 日期按t所处的岁在rule下所用的时区划分
+// t不落在rule所编排的任何农历年之内时(如HistoricalLunarRule下1368年之前的日期), 第二个返回值为false
+// 例: 换算1645年之前的日期时, 可使用HistoricalLunarRule得到与当时历法相近的结果
+func NewLunarDateFromTimeWithRule(t time.Time, rule LunarRule) (LunarDate, bool) {
+	// 农历year年始于公历year年年初, t只可能落在农历year年或year-1年中.
+	// 各岁所用时区的时差不足一日, 按rule.Location()取公历年份不影响这一判断
+	year := t.In(rule.Location()).Year()
+	for _, y := range [2]int{year, year - 1} {
+		info, ok := lunarYearOf(y, rule)
+		if !ok {
+			continue
+		}
+		for _, m := range info.months {
+			day := dayNumberIn(t, suiLocation(rule, y, m.month))
+			if day >= m.start && day < m.start+int64(m.days) {
+				return LunarDate{
+					Year:        info.year,
+					Month:       m.month,
+					Day:         int(day-m.start) + 1,
+					IsLeapMonth: m.leap,
+				}, true
+			}
+		}
+	}
+
+	return LunarDate{}, false
+}
+
+// Time 返回该农历日期当天零点(东经120°标准时)
+// 该日期不存在时(如闰月不存在, 或小月的三十), 第二个返回值为false
+func (d LunarDate) Time() (time.Time, bool) {
+	return d.TimeWithRule(ModernLunarRule)
+}
+
+// TimeWithRule 按rule规则返回该农历日期当天零点, 时区为该日所处的岁在rule下划分日期所用的时区
+// rule不支持该日期所处的岁时, 第二个返回值为false
+func (d LunarDate) TimeWithRule(rule LunarRule) (time.Time, bool) {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return time.Time{}, false
+	}
+
+	info, ok := lunarYearOf(d.Year, rule)
+	if !ok {
+		return time.Time{}, false
+	}
+	for _, m := range info.months {
+		if m.month == d.Month && m.leap == d.IsLeapMonth {
+			if d.Day > m.days {
+				return time.Time{}, false
+			}
+			return dayTimeIn(m.start+int64(d.Day)-1, suiLocation(rule, d.Year, d.Month)), true
+		}
+	}
+	return time.Time{}, false
+}
+
+// suiLocation 返回农历year年month月所属的岁在rule下划分日期所用的时区, 十一月与十二月属于下一岁
+// rule不支持该岁时(如朔闰表中超出fallback范围的年份)使用rule.Location()
+func suiLocation(rule LunarRule, year, month int) *time.Location {
+	sui := year
+	if month >= 11 {
+		sui++
+	}
+	if r := resolveLunarRule(rule, sui); r != nil {
+		return r.Location()
+	}
+	return rule.Location()
+}
+
+// IsValid 该农历日期是否存在
+func (d LunarDate) IsValid() bool {
+	_, valid := d.Time()
+	return valid
+}
+
+// SexagenaryYear 返回该农历年的干支
+func (d LunarDate) SexagenaryYear() sexagenary.SexagenaryTerm {
+	return lunarSexagenaryYear(d.Year)
+}
+
+// String 返回农历日期中文, 年份以干支表示
+// 例: 甲辰年正月初一, 乙巳年闰六月初一
+func (d LunarDate) String(simplified bool) string {
+	if d.Month < 1 || d.Month > 12 || d.Day < 1 || d.Day > 30 {
+		return ""
+	}
+
+	month := lunarMonthWords[d.Month-1]
+	leap := "闰"
+	if !simplified {
+		month = lunarMonthWordsTraditional[d.Month-1]
+		leap = "閏"
+	}
+	if !d.IsLeapMonth {
+		leap = ""
+	}
+
+	return d.SexagenaryYear().String() + "年" + leap + month + "月" + lunarDayWords[d.Day-1]
+}
@@ -0,0 +1,126 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewLunarDateFromTime(t *testing.T) {
+	inputs := []time.Time{
+		time.Date(1900, 1, 31, 0, 0, 0, 0, baseTimezone),
+		time.Date(1984, 2, 2, 0, 0, 0, 0, baseTimezone),
+		time.Date(2000, 2, 5, 0, 0, 0, 0, baseTimezone),
+		time.Date(2017, 7, 23, 0, 0, 0, 0, baseTimezone),
+		time.Date(2020, 1, 25, 0, 0, 0, 0, baseTimezone),
+		time.Date(2020, 5, 23, 0, 0, 0, 0, baseTimezone),
+		time.Date(2023, 1, 21, 23, 59, 59, 0, baseTimezone),
+		time.Date(2023, 1, 21, 16, 0, 0, 0, time.UTC),
+		time.Date(2023, 3, 22, 0, 0, 0, 0, baseTimezone),
+		time.Date(2024, 2, 10, 0, 0, 0, 0, baseTimezone),
+		time.Date(2024, 9, 17, 12, 0, 0, 0, baseTimezone),
+		time.Date(2025, 7, 25, 0, 0, 0, 0, baseTimezone),
+		time.Date(2033, 12, 22, 0, 0, 0, 0, baseTimezone),
+	}
+	expect := []LunarDate{
+		{Year: 1900, Month: 1, Day: 1},
+		{Year: 1984, Month: 1, Day: 1},
+		{Year: 2000, Month: 1, Day: 1},
+		{Year: 2017, Month: 6, Day: 1, IsLeapMonth: true},
+		{Year: 2020, Month: 1, Day: 1},
+		{Year: 2020, Month: 4, Day: 1, IsLeapMonth: true},
+		{Year: 2022, Month: 12, Day: 30},
+		{Year: 2023, Month: 1, Day: 1},
+		{Year: 2023, Month: 2, Day: 1, IsLeapMonth: true},
+		{Year: 2024, Month: 1, Day: 1},
+		{Year: 2024, Month: 8, Day: 15},
+		{Year: 2025, Month: 6, Day: 1, IsLeapMonth: true},
+		{Year: 2033, Month: 11, Day: 1, IsLeapMonth: true},
+	}
+
+	for idx, each := range inputs {
+		actual := NewLunarDateFromTime(each)
+		if actual != expect[idx] {
+			t.Fatalf("lunar date of %s should be %+v, got %+v", each, expect[idx], actual)
+		}
+	}
+}
+
+func TestLunarDate(t *testing.T) {
+	t.Run("test Time method", func(t *testing.T) {
+		inputs := []LunarDate{
+			{Year: 2024, Month: 1, Day: 1},
+			{Year: 2023, Month: 2, Day: 29, IsLeapMonth: true},
+			{Year: 2023, Month: 3, Day: 1, IsLeapMonth: true},
+			{Year: 2024, Month: 0, Day: 1},
+			{Year: 2024, Month: 1, Day: 31},
+		}
+		expect := []struct {
+			time.Time
+			Valid bool
+		}{
+			{time.Date(2024, 2, 10, 0, 0, 0, 0, baseTimezone), true},
+			{time.Date(2023, 4, 19, 0, 0, 0, 0, baseTimezone), true},
+			{time.Time{}, false},
+			{time.Time{}, false},
+			{time.Time{}, false},
+		}
+
+		for idx, each := range inputs {
+			actual, valid := each.Time()
+			if !actual.Equal(expect[idx].Time) || valid != expect[idx].Valid {
+				t.Fatalf("time of %+v should be %s and %v, got %s and %v", each, expect[idx].Time, expect[idx].Valid, actual, valid)
+			}
+		}
+	})
+
+	t.Run("test round trip", func(t *testing.T) {
+		for day := time.Date(1950, 1, 1, 0, 0, 0, 0, baseTimezone); day.Year() < 2050; day = day.AddDate(0, 0, 97) {
+			ld := NewLunarDateFromTime(day)
+			actual, valid := ld.Time()
+			if !valid || !actual.Equal(day) {
+				t.Fatalf("%s was converted to %+v, which converts back to %s", day, ld, actual)
+			}
+		}
+	})
+
+	t.Run("test round trip outside the lunar table", func(t *testing.T) {
+		inputs := []time.Time{
+			time.Date(1850, 3, 1, 0, 0, 0, 0, baseTimezone),
+			time.Date(1899, 12, 31, 0, 0, 0, 0, baseTimezone),
+			time.Date(2101, 2, 1, 0, 0, 0, 0, baseTimezone),
+			time.Date(2500, 8, 1, 0, 0, 0, 0, baseTimezone),
+		}
+		for _, day := range inputs {
+			ld := NewLunarDateFromTime(day)
+			actual, valid := ld.Time()
+			if !valid || !actual.Equal(day) {
+				t.Fatalf("%s was converted to %+v, which converts back to %s", day, ld, actual)
+			}
+		}
+	})
+
+	t.Run("test String method", func(t *testing.T) {
+		inputs := []LunarDate{
+			{Year: 2024, Month: 1, Day: 1},
+			{Year: 2025, Month: 6, Day: 21, IsLeapMonth: true},
+			{Year: 1984, Month: 12, Day: 30},
+			{Year: 1984, Month: 13, Day: 30},
+		}
+		expect := []struct {
+			Simplified  string
+			Traditional string
+		}{
+			{"甲辰年正月初一", "甲辰年正月初一"},
+			{"乙巳年闰六月廿一", "乙巳年閏六月廿一"},
+			{"甲子年腊月三十", "甲子年臘月三十"},
+			{"", ""},
+		}
+
+		for idx, each := range inputs {
+			s, tr := each.String(true), each.String(false)
+			if s != expect[idx].Simplified || tr != expect[idx].Traditional {
+				t.Fatalf("string of %+v should be %s/%s, got %s/%s", each, expect[idx].Simplified, expect[idx].Traditional, s, tr)
+			}
+		}
+	})
+}
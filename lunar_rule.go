@@ -0,0 +1,156 @@
+package calendar
+
+import (
+	"math"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/lunisolar"
+	"github.com/hsldymq/go-chinese-calendar/lunar"
+	"github.com/hsldymq/go-chinese-calendar/solar"
+)
+
+// LunarRule 农历的编排规则
+// 各朝历法在节气与朔日的取法, 以及计算所依据的地点上各有不同, 但都以含冬至的月为十一月,
+// 并在两个冬至之间有13个月时以第一个不含中气的月为闰月. 实现该接口即可替换农历换算所用的规则
+type LunarRule interface {
+	// SolarTermTime 返回公历year年中节气st的时刻
+	SolarTermTime(st solar.SolarTerm, year int) time.Time
+	// NewMoonAfter 返回t之后(含t)的第一个朔
+	NewMoonAfter(t time.Time) time.Time
+	// NewMoonBefore 返回t之前(含t)的最近一个朔
+	NewMoonBefore(t time.Time) time.Time
+	// Location 划分日期所依据的时区
+	Location() *time.Location
+}
+
+// ModernLunarRule 现行农历规则(GB/T 33661-2017): 定气定朔, 以东经120°标准时划分日期
+// 1645年时宪历起采用定气, 其后的农历均可用该规则换算
+var ModernLunarRule LunarRule = lunisolar.ModernRule{}
+
+// MeanSolarTermLunarRule 平气定朔规则, 近似明代大统历(承袭元代授时历)的编排方式
+// 以授时历历元至元十七年(1280年)的冬至为起点, 按岁实365.2425日均分二十四气, 以北京(东经116.4°)地方平时划分日期.
+// 历元冬至取自本库的天文算法而非历书记载, 朔日亦用现代算法代替授时历的定朔算法,
+// 因此在朔或中气临近子夜时, 个别月的大小与闰月位置可能与当时颁行的历书不同
+var MeanSolarTermLunarRule LunarRule = newMeanSolarTermLunarRule(1280, 365.2425, 116.4)
+
+// HistoricalLunarRule 历史农历规则: 1368年至1644年的岁采用MeanSolarTermLunarRule, 1645年及之后采用ModernLunarRule
+// 大统历自洪武元年(1368年)起施行, 时宪历于顺治二年(1645年)颁行, 两者正月所属的岁分别自前一年冬至起算.
+// 该规则只覆盖大统历与时宪历以来的年份, 所跨的岁在1368年之前的农历年(即1367年及更早)无法换算,
+// NewLunarYearWithRule, NewLunarDateFromTimeWithRule与TimeWithRule对这些年份的第二个返回值均为false
+var HistoricalLunarRule LunarRule = switchLunarRule{
+	from:   1368,
+	before: MeanSolarTermLunarRule,
+	after:  ModernLunarRule,
+	year:   1645,
+}
+
+// meanSolarTermLunarRule 平气定朔
+type meanSolarTermLunarRule struct {
+	// epoch 历元冬至的时刻
+	epoch time.Time
+	// epochYear 历元冬至所在的公历年
+	epochYear int
+	// yearLength 岁实, 单位日
+	yearLength float64
+	location   *time.Location
+}
+
+// newMeanSolarTermLunarRule 以公历epochYear年的冬至为历元, yearLength为岁实, 在东经longitude处划分日期
+func newMeanSolarTermLunarRule(epochYear int, yearLength, longitude float64) meanSolarTermLunarRule {
+	offset := time.Duration(longitude * 4 * float64(time.Minute)).Round(time.Second)
+	return meanSolarTermLunarRule{
+		epoch:      solar.SolarTermEnum.TheWinterSolstice.Time(epochYear),
+		epochYear:  epochYear,
+		yearLength: yearLength,
+		location:   time.FixedZone("LMT", int(offset/time.Second)),
+	}
+}
+
+// SolarTermTime 自历元冬至起, 每气相隔岁实的1/24
+// 小寒至大雪落在上一个冬至之后, 冬至本身则是公历year年年末的冬至
+func (r meanSolarTermLunarRule) SolarTermTime(st solar.SolarTerm, year int) time.Time {
+	if !st.IsValid() {
+		return time.Time{}
+	}
+
+	n := int(st.Move(-int(solar.SolarTermEnum.TheWinterSolstice)))
+	days := float64(year-1-r.epochYear)*r.yearLength + float64(n)*r.yearLength/24
+	if n == 0 {
+		days += r.yearLength
+	}
+	// time.Duration只能表示约290年, 整日部分需单独累加
+	whole := math.Floor(days)
+	t := r.epoch.AddDate(0, 0, int(whole))
+	return t.Add(time.Duration((days - whole) * 24 * float64(time.Hour))).Round(time.Millisecond)
+}
+
+func (r meanSolarTermLunarRule) NewMoonAfter(t time.Time) time.Time {
+	return lunar.PhaseEnum.NewMoon.Next(t)
+}
+
+func (r meanSolarTermLunarRule) NewMoonBefore(t time.Time) time.Time {
+	return lunar.PhaseEnum.NewMoon.Previous(t)
+}
+
+func (r meanSolarTermLunarRule) Location() *time.Location {
+	return r.location
+}
+
+// lunarRuleSelector 按岁选择实际规则的LunarRule
+type lunarRuleSelector interface {
+	// ruleFor 返回公历year-1年冬至至year年冬至这一岁所用的规则, 不支持该岁时返回nil
+	ruleFor(year int) LunarRule
+}
+
+// resolveLunarRule 返回year这一岁实际所用的规则, rule不支持该岁时返回nil
+func resolveLunarRule(rule LunarRule, year int) LunarRule {
+	if s, ok := rule.(lunarRuleSelector); ok {
+		return resolveLunarRule(s.ruleFor(year), year)
+	}
+	return rule
+}
+
+// switchLunarRule 自from这一岁起采用before规则, 自year这一岁起切换为after规则, from之前的岁不支持
+// 农历换算按岁选择规则, 直接调用其方法时则按公历年份选择, from之前的年份返回零值
+type switchLunarRule struct {
+	from   int
+	before LunarRule
+	after  LunarRule
+	year   int
+}
+
+func (r switchLunarRule) ruleFor(year int) LunarRule {
+	switch {
+	case year < r.from:
+		return nil
+	case year < r.year:
+		return r.before
+	default:
+		return r.after
+	}
+}
+
+func (r switchLunarRule) SolarTermTime(st solar.SolarTerm, year int) time.Time {
+	if rule := r.ruleFor(year); rule != nil {
+		return rule.SolarTermTime(st, year)
+	}
+	return time.Time{}
+}
+
+func (r switchLunarRule) NewMoonAfter(t time.Time) time.Time {
+	if rule := r.ruleFor(t.Year()); rule != nil {
+		return rule.NewMoonAfter(t)
+	}
+	return time.Time{}
+}
+
+func (r switchLunarRule) NewMoonBefore(t time.Time) time.Time {
+	if rule := r.ruleFor(t.Year()); rule != nil {
+		return rule.NewMoonBefore(t)
+	}
+	return time.Time{}
+}
+
+func (r switchLunarRule) Location() *time.Location {
+	return r.after.Location()
+}
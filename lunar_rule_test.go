@@ -0,0 +1,139 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/solar"
+)
+
+func TestMeanSolarTermLunarRule(t *testing.T) {
+	rule := MeanSolarTermLunarRule
+	yearLength := time.Duration(365.2425 * 24 * float64(time.Hour))
+	winterSolstice := solar.SolarTermEnum.TheWinterSolstice
+
+	t.Run("test solar term intervals", func(t *testing.T) {
+		inputs := []int{1300, 1500, 1643}
+		for _, year := range inputs {
+			from := rule.SolarTermTime(winterSolstice, year-1)
+			if d := rule.SolarTermTime(winterSolstice, year).Sub(from) - yearLength; d < -time.Millisecond || d > time.Millisecond {
+				t.Fatalf("mean winter solstices of %d and %d should be %s apart, got %s", year-1, year, yearLength, d+yearLength)
+			}
+			for n, st := 1, winterSolstice.Move(1); st != winterSolstice; n, st = n+1, st.Move(1) {
+				expect := from.Add(yearLength / 24 * time.Duration(n))
+				if d := rule.SolarTermTime(st, year).Sub(expect); d < -time.Millisecond || d > time.Millisecond {
+					t.Fatalf("mean %s of %d should be %s, got %s", st.String(true), year, expect, rule.SolarTermTime(st, year))
+				}
+			}
+		}
+	})
+
+	t.Run("test lunar years", func(t *testing.T) {
+		for year := 1368; year < 1645; year++ {
+			ly, _ := NewLunarYearWithRule(year, rule)
+			days := ly.Days()
+			if ly.MonthCount() == 12 && (days < 353 || days > 356) || ly.MonthCount() == 13 && (days < 383 || days > 385) || ly.MonthCount() < 12 || ly.MonthCount() > 13 {
+				t.Fatalf("lunar year %d should have 12 or 13 months in proper length, got %d months and %d days", year, ly.MonthCount(), days)
+			}
+
+			next, _ := NewLunarYearWithRule(year+1, rule)
+			if end := ly.Months[0].Start.AddDate(0, 0, days); !end.Equal(next.Months[0].Start) {
+				t.Fatalf("lunar year %d should end right before %s, got %s", year, next.Months[0].Start, end)
+			}
+		}
+	})
+}
+
+func TestHistoricalLunarRule(t *testing.T) {
+	t.Run("test leap months", func(t *testing.T) {
+		// 崇祯四年(1631年)闰十一月, 吴桥兵变即发生于该月; 按现行规则则闰在次年二月
+		inputs := []int{1631, 1632}
+		expect := []struct {
+			Historical int
+			Modern     int
+		}{
+			{11, 0},
+			{0, 2},
+		}
+
+		for idx, year := range inputs {
+			historicalYear, _ := NewLunarYearWithRule(year, HistoricalLunarRule)
+			modernYear, _ := NewLunarYearWithRule(year, ModernLunarRule)
+			historical, modern := historicalYear.LeapMonth(), modernYear.LeapMonth()
+			if historical != expect[idx].Historical || modern != expect[idx].Modern {
+				t.Fatalf("leap month of %d should be %d historically and %d by modern rule, got %d and %d",
+					year,
+					expect[idx].Historical,
+					expect[idx].Modern,
+					historical,
+					modern,
+				)
+			}
+		}
+	})
+
+	t.Run("test modern years", func(t *testing.T) {
+		inputs := []int{1645, 1900, 2024}
+		for _, year := range inputs {
+			expect := NewLunarYear(year)
+			actual, ok := NewLunarYearWithRule(year, HistoricalLunarRule)
+			if !ok {
+				t.Fatalf("lunar year %d should be supported by historical rule", year)
+			}
+			if len(actual.Months) != len(expect.Months) {
+				t.Fatalf("lunar year %d should have %d months, got %d", year, len(expect.Months), len(actual.Months))
+			}
+			for i := range expect.Months {
+				if actual.Months[i] != expect.Months[i] {
+					t.Fatalf("the %dth month of %d should be %+v, got %+v", i, year, expect.Months[i], actual.Months[i])
+				}
+			}
+		}
+	})
+
+	t.Run("test lunar date conversion", func(t *testing.T) {
+		d := LunarDate{Year: 1631, Month: 11, Day: 1, IsLeapMonth: true}
+		if d.IsValid() {
+			t.Fatalf("%+v should not exist by modern rule", d)
+		}
+
+		tm, ok := d.TimeWithRule(HistoricalLunarRule)
+		if !ok {
+			t.Fatalf("%+v should exist by historical rule", d)
+		}
+		if actual, ok := NewLunarDateFromTimeWithRule(tm, HistoricalLunarRule); !ok || actual != d {
+			t.Fatalf("lunar date of %s should be %+v, got %+v", tm, d, actual)
+		}
+	})
+
+	t.Run("test supported range", func(t *testing.T) {
+		// 洪武元年(1368年)正月所属的岁自1367年冬至起算, 1367年的十一月与十二月即属于该岁
+		inputs := []int{1300, 1367, 1368, 1644}
+		expect := []bool{false, false, true, true}
+		for idx, year := range inputs {
+			if _, ok := NewLunarYearWithRule(year, HistoricalLunarRule); ok != expect[idx] {
+				t.Fatalf("lunar year %d should be supported: %t, got %t", year, expect[idx], ok)
+			}
+			if _, ok := (LunarDate{Year: year, Month: 6, Day: 1}).TimeWithRule(HistoricalLunarRule); ok != expect[idx] {
+				t.Fatalf("lunar date of %d should be supported: %t, got %t", year, expect[idx], ok)
+			}
+		}
+
+		tm := time.Date(1368, 1, 1, 12, 0, 0, 0, baseTimezone)
+		if d, ok := NewLunarDateFromTimeWithRule(tm, HistoricalLunarRule); ok {
+			t.Fatalf("%s should not be supported by historical rule, got %+v", tm, d)
+		}
+	})
+
+	t.Run("test location of the first sui under modern rule", func(t *testing.T) {
+		// 1644年冬至之后的岁已采用时宪历, 日期按东经120°标准时划分; 按北京地方平时该时刻仍在前一天
+		tm := time.Date(1644, 12, 25, 0, 5, 0, 0, baseTimezone)
+		expect := NewLunarDateFromTime(tm)
+		if actual, ok := NewLunarDateFromTimeWithRule(tm, HistoricalLunarRule); !ok || actual != expect {
+			t.Fatalf("lunar date of %s should be %+v, got %+v", tm, expect, actual)
+		}
+		if back, ok := expect.TimeWithRule(HistoricalLunarRule); !ok || !back.Equal(time.Date(1644, 12, 25, 0, 0, 0, 0, baseTimezone)) {
+			t.Fatalf("%+v should begin at 1644-12-25 00:00 +0800, got %s", expect, back)
+		}
+	})
+}
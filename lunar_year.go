@@ -0,0 +1,242 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/lunisolar"
+	"github.com/hsldymq/go-chinese-calendar/sexagenary"
+)
+
+// LunarYear 农历年, 自正月初一至除夕
+type LunarYear struct {
+	// Year 农历年, 以正月初一所在的公历年份表示
+	Year int
+	// Months 该年的各月, 按时间顺序排列, 闰月紧随同名的月之后
+	Months []LunarMonth
+}
+
+// LunarMonth 农历月
+type LunarMonth struct {
+	// Month 月份, 1-12
+	Month int
+	// IsLeapMonth 是否为闰月
+	IsLeapMonth bool
+	// Days 该月天数, 大月30天, 小月29天
+	Days int
+	// Start 该月初一零点(东经120°标准时)
+	Start time.Time
+	// Term 该月的干支, 按五虎遁自年干推出, 闰月沿用所闰之月的干支
+	Term sexagenary.SexagenaryTerm
+}
+
+// NewLunarYear 返回农历year年的月份信息
+// 1900年至2100年之间查预计算表, 其余年份按天文算法计算
+func NewLunarYear(year int) LunarYear {
+	// 现行规则适用于任何年份
+	info, _ := lunarYearOf(year, ModernLunarRule)
+	return newLunarYearFromInfo(info)
+}
+
+// NewLunarYearWithRule 按rule规则返回农历year年的月份信息
+// rule不支持该年所跨的岁时(如HistoricalLunarRule下1368年之前的年份), 第二个返回值为false
+// 例: NewLunarYearWithRule(1500, HistoricalLunarRule) 按平气规则编排1500年的各月
+func NewLunarYearWithRule(year int, rule LunarRule) (LunarYear, bool) {
+	info, ok := lunarYearOf(year, rule)
+	if !ok {
+		return LunarYear{}, false
+	}
+	return newLunarYearFromInfo(info), true
+}
+
+func newLunarYearFromInfo(info lunarYearInfo) LunarYear {
+	year := info.year
+	ly := LunarYear{
+		Year:   year,
+		Months: make([]LunarMonth, len(info.months)),
+	}
+
+	// 五虎遁: 甲子年的正月为丙寅, 之后每年的正月向后推12个干支
+	firstMonth := lunarSexagenaryYear(year).Index()%5*12 + 2
+	for i, m := range info.months {
+		ly.Months[i] = LunarMonth{
+			Month:       m.month,
+			IsLeapMonth: m.leap,
+			Days:        m.days,
+			Start:       dayTime(m.start),
+			Term:        sexagenary.NewSexagenaryTermFromIndex(firstMonth + m.month - 1),
+		}
+	}
+	return ly
+}
+
+// LeapMonth 返回闰月的月份, 无闰月时返回0
+func (y LunarYear) LeapMonth() int {
+	for _, m := range y.Months {
+		if m.IsLeapMonth {
+			return m.Month
+		}
+	}
+	return 0
+}
+
+// MonthCount 返回该年的月数, 平年12个月, 闰年13个月
+func (y LunarYear) MonthCount() int {
+	return len(y.Months)
+}
+
+// Days 返回该年的总天数
+func (y LunarYear) Days() int {
+	days := 0
+	for _, m := range y.Months {
+		days += m.Days
+	}
+	return days
+}
+
+// SexagenaryTerm 返回该年的干支
+func (y LunarYear) SexagenaryTerm() sexagenary.SexagenaryTerm {
+	return lunarSexagenaryYear(y.Year)
+}
+
+// IsBig 是否为大月
+func (m LunarMonth) IsBig() bool {
+	return m.Days == 30
+}
+
+// lunarSexagenaryYear 返回农历year年的干支, 以sexagenaryYearBase所在的甲子年为参考
+func lunarSexagenaryYear(year int) sexagenary.SexagenaryTerm {
+	return sexagenary.NewSexagenaryTermFromIndex(year - sexagenaryYearBase.Year())
+}
+
+// lunarMonthSpan 农历月在日序号上的跨度
+type lunarMonthSpan struct {
+	// start 初一的日序号
+	start int64
+	// days 该月天数
+	days int
+	// month 月份, 1-12
+	month int
+	// leap 是否为闰月
+	leap bool
+}
+
+// lunarYearInfo 一个农历年(正月初一至除夕)的月份编排
+type lunarYearInfo struct {
+	year   int
+	months []lunarMonthSpan
+}
+
+// end 返回该年之后下一年正月初一的日序号
+func (info lunarYearInfo) end() int64 {
+	last := info.months[len(info.months)-1]
+	return last.start + int64(last.days)
+}
+
+//go:generate go run ./internal/gen/lunartable -o lunar_table.go
+
+// lunarYearFromTable 从预计算表lunarTable中解出农历year年的月份编排
+// 表中每年一项, 各位含义如下:
+//
+//	0-3位:   闰月月份, 0表示无闰月
+//	4-16位:  按时间顺序各月的大小, 第4+i位为1表示第i个月为大月(30天), 否则为小月(29天)
+//	17-22位: 正月初一距公历当年1月1日的天数
+func lunarYearFromTable(year int) (lunarYearInfo, bool) {
+	idx := year - lunarTableFirstYear
+	if idx < 0 || idx >= len(lunarTable) {
+		return lunarYearInfo{}, false
+	}
+
+	v := lunarTable[idx]
+	leapMonth := int(v & 0xf)
+	count := 12
+	if leapMonth > 0 {
+		count = 13
+	}
+
+	info := lunarYearInfo{
+		year:   year,
+		months: make([]lunarMonthSpan, count),
+	}
+	start := dayNumber(time.Date(year, 1, 1, 0, 0, 0, 0, baseTimezone)) + int64(v>>17&0x3f)
+	month := 0
+	for i := range info.months {
+		leap := leapMonth > 0 && i == leapMonth
+		if !leap {
+			month++
+		}
+		days := 29
+		if v>>(4+uint(i))&1 == 1 {
+			days = 30
+		}
+		info.months[i] = lunarMonthSpan{
+			start: start,
+			days:  days,
+			month: month,
+			leap:  leap,
+		}
+		start += int64(days)
+	}
+
+	return info, true
+}
+
+// lunarYearOf 按rule规则返回农历year年的月份编排, rule不支持该年所跨的岁时第二个返回值为false
+// rule为朔闰表且记录了该年时以表为准; 该年所跨的两个岁均采用现行规则时, 预计算表范围内查表, 其余情况按天文算法计算
+func lunarYearOf(year int, rule LunarRule) (lunarYearInfo, bool) {
+	if p, ok := rule.(lunarYearProvider); ok {
+		if info, ok := p.lunarYear(year); ok {
+			return info, true
+		}
+	}
+	this, next := resolveLunarRule(rule, year), resolveLunarRule(rule, year+1)
+	if this == nil || next == nil {
+		return lunarYearInfo{}, false
+	}
+	if this == ModernLunarRule && next == ModernLunarRule {
+		if info, ok := lunarYearFromTable(year); ok {
+			return info, true
+		}
+	}
+	return computeLunarYear(year, rule), true
+}
+
+// computeLunarYear 按rule规则计算农历year年的月份编排, 各岁分别采用其实际所用的规则
+// 调用方须保证rule支持该年所跨的两个岁
+func computeLunarYear(year int, rule LunarRule) lunarYearInfo {
+	months := lunisolar.YearMonths(year, func(sui int) lunisolar.Rule {
+		return resolveLunarRule(rule, sui)
+	})
+	info := lunarYearInfo{
+		year:   year,
+		months: make([]lunarMonthSpan, len(months)),
+	}
+	for i, m := range months {
+		info.months[i] = lunarMonthSpan{
+			start: m.Start,
+			days:  m.Days,
+			month: m.Month,
+			leap:  m.Leap,
+		}
+	}
+	return info
+}
+
+// dayNumber 返回t在东经120°标准时下所处日期的日序号, 1970年1月1日为0
+func dayNumber(t time.Time) int64 {
+	return dayNumberIn(t, baseTimezone)
+}
+
+// dayNumberIn 返回t在loc时区下所处日期的日序号
+func dayNumberIn(t time.Time, loc *time.Location) int64 {
+	return lunisolar.DayNumberIn(t, loc)
+}
+
+// dayTime 返回日序号对应日期在东经120°标准时下的零点
+func dayTime(day int64) time.Time {
+	return dayTimeIn(day, baseTimezone)
+}
+
+// dayTimeIn 返回日序号对应日期在loc时区下的零点
+func dayTimeIn(day int64, loc *time.Location) time.Time {
+	return lunisolar.DayTimeIn(day, loc)
+}
@@ -0,0 +1,100 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewLunarYear(t *testing.T) {
+	inputs := []int{2023, 2024, 2025, 2033}
+	expect := []struct {
+		MonthCount int
+		LeapMonth  int
+		Days       int
+		Term       string
+		Start      time.Time
+	}{
+		{13, 2, 384, "癸卯", time.Date(2023, 1, 22, 0, 0, 0, 0, baseTimezone)},
+		{12, 0, 354, "甲辰", time.Date(2024, 2, 10, 0, 0, 0, 0, baseTimezone)},
+		{13, 6, 384, "乙巳", time.Date(2025, 1, 29, 0, 0, 0, 0, baseTimezone)},
+		{13, 11, 384, "癸丑", time.Date(2033, 1, 31, 0, 0, 0, 0, baseTimezone)},
+	}
+
+	for idx, year := range inputs {
+		ly := NewLunarYear(year)
+		e := expect[idx]
+		if ly.MonthCount() != e.MonthCount || ly.LeapMonth() != e.LeapMonth || ly.Days() != e.Days {
+			t.Fatalf("lunar year %d should have %d months, leap month %d and %d days, got %d, %d and %d",
+				year,
+				e.MonthCount,
+				e.LeapMonth,
+				e.Days,
+				ly.MonthCount(),
+				ly.LeapMonth(),
+				ly.Days(),
+			)
+		}
+		if ly.SexagenaryTerm().String() != e.Term || !ly.Months[0].Start.Equal(e.Start) {
+			t.Fatalf("lunar year %d should be %s and begin at %s, got %s and %s",
+				year,
+				e.Term,
+				e.Start,
+				ly.SexagenaryTerm(),
+				ly.Months[0].Start,
+			)
+		}
+
+		next := NewLunarYear(year + 1)
+		if end := ly.Months[0].Start.AddDate(0, 0, ly.Days()); !end.Equal(next.Months[0].Start) {
+			t.Fatalf("lunar year %d should end right before %s, got %s", year, next.Months[0].Start, end)
+		}
+	}
+
+	t.Run("test month terms", func(t *testing.T) {
+		ly := NewLunarYear(2025)
+		expect := []string{"戊寅", "己卯", "庚辰", "辛巳", "壬午", "癸未", "癸未", "甲申", "乙酉", "丙戌", "丁亥", "戊子", "己丑"}
+		for idx, m := range ly.Months {
+			if m.Term.String() != expect[idx] {
+				t.Fatalf("the %dth month of 2025 should be %s, got %s", idx, expect[idx], m.Term)
+			}
+			if m.Days != 29 && m.Days != 30 || m.IsBig() != (m.Days == 30) {
+				t.Fatalf("the %dth month of 2025 has invalid length %d", idx, m.Days)
+			}
+		}
+	})
+}
+
+func TestLunarTable(t *testing.T) {
+	lastYear := lunarTableFirstYear + len(lunarTable) - 1
+	if lunarTableFirstYear != 1900 || lastYear != 2100 {
+		t.Fatalf("lunar table should cover 1900-2100, got %d-%d", lunarTableFirstYear, lastYear)
+	}
+
+	// 预计算表须与天文算法一致, 不一致时运行go generate重新生成
+	for year := lunarTableFirstYear; year <= lastYear; year++ {
+		expect := computeLunarYear(year, ModernLunarRule)
+		actual, ok := lunarYearFromTable(year)
+		if !ok || len(actual.months) != len(expect.months) {
+			t.Fatalf("lunar table of %d should have %d months, got %d", year, len(expect.months), len(actual.months))
+		}
+		for i := range expect.months {
+			if actual.months[i] != expect.months[i] {
+				t.Fatalf("the %dth month of %d in lunar table should be %+v, got %+v", i, year, expect.months[i], actual.months[i])
+			}
+		}
+	}
+
+	if _, ok := lunarYearFromTable(lunarTableFirstYear - 1); ok {
+		t.Fatalf("lunar table should not contain %d", lunarTableFirstYear-1)
+	}
+	if _, ok := lunarYearFromTable(lastYear + 1); ok {
+		t.Fatalf("lunar table should not contain %d", lastYear+1)
+	}
+}
+
+func BenchmarkNewLunarDateFromTime(b *testing.B) {
+	t := time.Date(2024, 9, 17, 12, 0, 0, 0, baseTimezone)
+	for i := 0; i < b.N; i++ {
+		NewLunarDateFromTime(t)
+	}
+}
@@ -0,0 +1,226 @@
+package sexagenary
+
+import "sort"
+
+// branchRelationTypeWords 地支关系中文简体
+var branchRelationTypeWords = [7]string{"六合", "三合", "三会", "冲", "刑", "害", "破"}
+
+// branchRelationTypeWordsTraditional 地支关系中文繁体
+var branchRelationTypeWordsTraditional = [7]string{"六合", "三合", "三會", "沖", "刑", "害", "破"}
+
+// BranchRelationType 地支关系的类型
+type BranchRelationType int
+
+func (t BranchRelationType) String(simplified bool) string {
+	if !t.IsValid() {
+		return ""
+	}
+	if simplified {
+		return branchRelationTypeWords[t]
+	}
+	return branchRelationTypeWordsTraditional[t]
+}
+
+func (t BranchRelationType) IsValid() bool {
+	return t >= 0 && t < 7
+}
+
+// BranchRelationTypeEnum 地支关系类型枚举项
+var BranchRelationTypeEnum = struct {
+	Combination            BranchRelationType // 六合, 如子丑合土
+	TripleCombination      BranchRelationType // 三合, 如申子辰三合水局
+	DirectionalCombination BranchRelationType // 三会, 如寅卯辰三会木局
+	Clash                  BranchRelationType // 六冲, 如子午冲
+	Punishment             BranchRelationType // 刑, 包括子卯相刑, 寅巳申与丑戌未三刑及其两两相刑, 辰午酉亥自刑
+	Harm                   BranchRelationType // 六害, 如子未害
+	Destruction            BranchRelationType // 六破, 如子酉破
+}{
+	Combination:            0,
+	TripleCombination:      1,
+	DirectionalCombination: 2,
+	Clash:                  3,
+	Punishment:             4,
+	Harm:                   5,
+	Destruction:            6,
+}
+
+// BranchRelation 若干地支之间的一个关系
+type BranchRelation struct {
+	Type BranchRelationType
+	// Branches 参与该关系的地支, 按惯用的顺序排列, 如申子辰
+	Branches []TerrestrialBranch
+	// Positions Branches中各地支在输入中的位置
+	Positions []int
+	// Element 合化或成局的五行, 刑冲害破等没有合化的关系为-1
+	Element WuXing
+}
+
+// String 返回该关系的中文
+// 例: 子丑合土, 申子辰三合水局, 寅卯辰三会木局, 子午冲, 寅巳申三刑, 辰辰自刑, 子未害, 子酉破
+func (r BranchRelation) String(simplified bool) string {
+	s := ""
+	for _, tb := range r.Branches {
+		s += tb.String()
+	}
+
+	switch r.Type {
+	case BranchRelationTypeEnum.Combination:
+		return s + "合" + r.Element.String()
+	case BranchRelationTypeEnum.TripleCombination, BranchRelationTypeEnum.DirectionalCombination:
+		return s + r.Type.String(simplified) + r.Element.String() + "局"
+	case BranchRelationTypeEnum.Punishment:
+		if len(r.Branches) == 3 {
+			return s + "三刑"
+		}
+		if r.Branches[0] == r.Branches[1] {
+			return s + "自刑"
+		}
+	}
+	return s + r.Type.String(simplified)
+}
+
+// branchRelationRule 地支关系表中的一项
+type branchRelationRule struct {
+	typ      BranchRelationType
+	branches []TerrestrialBranch
+	element  WuXing
+}
+
+// branchRelationRules 地支关系表, 按关系类型排列
+var branchRelationRules = func() []branchRelationRule {
+	tb := TerrestrialBranchEnum
+	we := WuXingEnum
+	none := WuXing(-1)
+	rule := func(typ BranchRelationType, element WuXing, branches ...TerrestrialBranch) branchRelationRule {
+		return branchRelationRule{typ: typ, branches: branches, element: element}
+	}
+	e := BranchRelationTypeEnum
+
+	return []branchRelationRule{
+		// 子丑合土, 寅亥合木, 卯戌合火, 辰酉合金, 巳申合水, 午未合土
+		rule(e.Combination, we.Earth, tb.Zi, tb.Chou),
+		rule(e.Combination, we.Wood, tb.Yin, tb.Hai),
+		rule(e.Combination, we.Fire, tb.Mao, tb.Xu),
+		rule(e.Combination, we.Metal, tb.Chen, tb.You),
+		rule(e.Combination, we.Water, tb.Si, tb.Shen),
+		rule(e.Combination, we.Earth, tb.Wu, tb.Wei),
+
+		// 申子辰合水局, 亥卯未合木局, 寅午戌合火局, 巳酉丑合金局
+		rule(e.TripleCombination, we.Water, tb.Shen, tb.Zi, tb.Chen),
+		rule(e.TripleCombination, we.Wood, tb.Hai, tb.Mao, tb.Wei),
+		rule(e.TripleCombination, we.Fire, tb.Yin, tb.Wu, tb.Xu),
+		rule(e.TripleCombination, we.Metal, tb.Si, tb.You, tb.Chou),
+
+		// 寅卯辰会东方木, 巳午未会南方火, 申酉戌会西方金, 亥子丑会北方水
+		rule(e.DirectionalCombination, we.Wood, tb.Yin, tb.Mao, tb.Chen),
+		rule(e.DirectionalCombination, we.Fire, tb.Si, tb.Wu, tb.Wei),
+		rule(e.DirectionalCombination, we.Metal, tb.Shen, tb.You, tb.Xu),
+		rule(e.DirectionalCombination, we.Water, tb.Hai, tb.Zi, tb.Chou),
+
+		// 相隔六位相冲
+		rule(e.Clash, none, tb.Zi, tb.Wu),
+		rule(e.Clash, none, tb.Chou, tb.Wei),
+		rule(e.Clash, none, tb.Yin, tb.Shen),
+		rule(e.Clash, none, tb.Mao, tb.You),
+		rule(e.Clash, none, tb.Chen, tb.Xu),
+		rule(e.Clash, none, tb.Si, tb.Hai),
+
+		// 子卯无礼之刑, 寅巳申恃势之刑, 丑戌未无恩之刑, 辰午酉亥自刑
+		rule(e.Punishment, none, tb.Zi, tb.Mao),
+		rule(e.Punishment, none, tb.Yin, tb.Si, tb.Shen),
+		rule(e.Punishment, none, tb.Yin, tb.Si),
+		rule(e.Punishment, none, tb.Si, tb.Shen),
+		rule(e.Punishment, none, tb.Shen, tb.Yin),
+		rule(e.Punishment, none, tb.Chou, tb.Xu, tb.Wei),
+		rule(e.Punishment, none, tb.Chou, tb.Xu),
+		rule(e.Punishment, none, tb.Xu, tb.Wei),
+		rule(e.Punishment, none, tb.Wei, tb.Chou),
+		rule(e.Punishment, none, tb.Chen, tb.Chen),
+		rule(e.Punishment, none, tb.Wu, tb.Wu),
+		rule(e.Punishment, none, tb.You, tb.You),
+		rule(e.Punishment, none, tb.Hai, tb.Hai),
+
+		// 子未害, 丑午害, 寅巳害, 卯辰害, 申亥害, 酉戌害
+		rule(e.Harm, none, tb.Zi, tb.Wei),
+		rule(e.Harm, none, tb.Chou, tb.Wu),
+		rule(e.Harm, none, tb.Yin, tb.Si),
+		rule(e.Harm, none, tb.Mao, tb.Chen),
+		rule(e.Harm, none, tb.Shen, tb.Hai),
+		rule(e.Harm, none, tb.You, tb.Xu),
+
+		// 子酉破, 卯午破, 辰丑破, 未戌破, 寅亥破, 巳申破
+		rule(e.Destruction, none, tb.Zi, tb.You),
+		rule(e.Destruction, none, tb.Mao, tb.Wu),
+		rule(e.Destruction, none, tb.Chen, tb.Chou),
+		rule(e.Destruction, none, tb.Wei, tb.Xu),
+		rule(e.Destruction, none, tb.Yin, tb.Hai),
+		rule(e.Destruction, none, tb.Si, tb.Shen),
+	}
+}()
+
+// NewBranchRelations 返回branches中任意两个或三个地支之间的全部关系, 按关系类型排列
+// 同一地支出现多次时, 每次出现都会分别参与匹配, 如[子, 丑, 子]有两个子丑合
+// 例: NewBranchRelations(子, 午, 辰, 申) -> [申子辰三合水局, 子午冲]
+func NewBranchRelations(branches ...TerrestrialBranch) []BranchRelation {
+	var relations []BranchRelation
+	n := len(branches)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			relations = appendBranchRelations(relations, branches, []int{i, j})
+			for k := j + 1; k < n; k++ {
+				relations = appendBranchRelations(relations, branches, []int{i, j, k})
+			}
+		}
+	}
+
+	sort.SliceStable(relations, func(a, b int) bool {
+		return relations[a].Type < relations[b].Type
+	})
+	return relations
+}
+
+// RelationsWith 返回该地支与other之间的全部关系
+func (tb TerrestrialBranch) RelationsWith(other TerrestrialBranch) []BranchRelation {
+	return NewBranchRelations(tb, other)
+}
+
+// appendBranchRelations 将branches中positions处的地支所构成的关系追加到relations
+func appendBranchRelations(relations []BranchRelation, branches []TerrestrialBranch, positions []int) []BranchRelation {
+	for _, rule := range branchRelationRules {
+		if len(rule.branches) != len(positions) {
+			continue
+		}
+		matched, ok := matchBranchRule(rule.branches, branches, positions)
+		if !ok {
+			continue
+		}
+		relations = append(relations, BranchRelation{
+			Type:      rule.typ,
+			Branches:  append([]TerrestrialBranch(nil), rule.branches...),
+			Positions: matched,
+			Element:   rule.element,
+		})
+	}
+	return relations
+}
+
+// matchBranchRule 检查positions处的地支是否恰好构成want(不计顺序), 是则返回按want的顺序排列的位置
+func matchBranchRule(want []TerrestrialBranch, branches []TerrestrialBranch, positions []int) ([]int, bool) {
+	matched := make([]int, len(want))
+	used := make([]bool, len(positions))
+	for i, tb := range want {
+		found := false
+		for j, p := range positions {
+			if !used[j] && branches[p] == tb {
+				used[j] = true
+				matched[i] = p
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return matched, true
+}
@@ -0,0 +1,88 @@
+package sexagenary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewBranchRelations(t *testing.T) {
+	tb := TerrestrialBranchEnum
+	inputs := [][]TerrestrialBranch{
+		{tb.Zi, tb.Chou},
+		{tb.Wu, tb.Wei},
+		{tb.Zi, tb.Wu, tb.Chen, tb.Shen},
+		{tb.Yin, tb.Mao, tb.Chen},
+		{tb.Yin, tb.Si, tb.Shen},
+		{tb.Chou, tb.Xu, tb.Wei},
+		{tb.Zi, tb.Mao},
+		{tb.Chen, tb.Chen},
+		{tb.Zi, tb.Chou, tb.Zi},
+		{tb.Zi, tb.Yin},
+		{},
+	}
+	expect := [][]string{
+		{"子丑合土"},
+		{"午未合土"},
+		{"申子辰三合水局", "子午冲"},
+		{"寅卯辰三会木局", "卯辰害"},
+		{"巳申合水", "寅申冲", "寅巳刑", "寅巳申三刑", "申寅刑", "巳申刑", "寅巳害", "巳申破"},
+		{"丑未冲", "丑戌刑", "丑戌未三刑", "未丑刑", "戌未刑", "未戌破"},
+		{"子卯刑"},
+		{"辰辰自刑"},
+		{"子丑合土", "子丑合土"},
+		{},
+		{},
+	}
+
+	for idx, each := range inputs {
+		relations := NewBranchRelations(each...)
+		actual := make([]string, len(relations))
+		for i, r := range relations {
+			actual[i] = r.String(true)
+		}
+		if strings.Join(actual, ",") != strings.Join(expect[idx], ",") {
+			t.Fatalf("relations of %v should be %v, got %v", each, expect[idx], actual)
+		}
+	}
+
+	t.Run("test positions and element", func(t *testing.T) {
+		relations := NewBranchRelations(tb.Chen, tb.Wu, tb.Shen, tb.Zi)
+		r := relations[0]
+		if r.Type != BranchRelationTypeEnum.TripleCombination || r.Element != WuXingEnum.Water {
+			t.Fatalf("the first relation should be 三合水局, got %s", r.String(true))
+		}
+		expectPositions := []int{2, 3, 0}
+		for i, p := range r.Positions {
+			if p != expectPositions[i] {
+				t.Fatalf("positions of %s should be %v, got %v", r.String(true), expectPositions, r.Positions)
+			}
+		}
+
+		clash := relations[1]
+		if clash.Type != BranchRelationTypeEnum.Clash || clash.Element.IsValid() || clash.Positions[0] != 3 || clash.Positions[1] != 1 {
+			t.Fatalf("子午冲 should be at [3 1] without element, got %v and %d", clash.Positions, clash.Element)
+		}
+	})
+
+	t.Run("test modifying returned branches", func(t *testing.T) {
+		relations := NewBranchRelations(tb.Zi, tb.Chou)
+		relations[0].Branches[0] = tb.Hai
+		if actual := NewBranchRelations(tb.Zi, tb.Chou); len(actual) != 1 || actual[0].String(true) != "子丑合土" {
+			t.Fatalf("modifying a returned relation should not affect later results, got %v", actual)
+		}
+		if actual := NewBranchRelations(tb.Hai, tb.Chou); len(actual) != 0 {
+			t.Fatalf("亥 and 丑 should have no relation, got %v", actual)
+		}
+	})
+
+	t.Run("test String method", func(t *testing.T) {
+		r := NewBranchRelations(tb.Hai, tb.Zi, tb.Chou)[1]
+		if r.String(false) != "亥子丑三會水局" {
+			t.Fatalf("traditional name of %s should be 亥子丑三會水局, got %s", r.String(true), r.String(false))
+		}
+		r = tb.Mao.RelationsWith(tb.You)[0]
+		if r.String(false) != "卯酉沖" {
+			t.Fatalf("traditional name of %s should be 卯酉沖, got %s", r.String(true), r.String(false))
+		}
+	})
+}
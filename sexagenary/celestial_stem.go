@@ -51,6 +51,60 @@ func (cs CelestialStem) Move(nth int) CelestialStem {
 	return CelestialStem(ncs)
 }
 
+// MonthTerm 以该天干为年干时, 地支为tb的月的干支(五虎遁)
+// 甲己之年丙作首, 乙庚之岁戊为头, 丙辛必定寻庚起, 丁壬壬位顺行流, 更有戊癸何方觅, 甲寅之上好追求
+// 例: x=甲, x.MonthTerm(寅) -> 丙寅
+//     x=乙, x.MonthTerm(子) -> 戊子
+func (cs CelestialStem) MonthTerm(tb TerrestrialBranch) SexagenaryTerm {
+	// 寅月的天干, 五年一循环
+	first := CelestialStemEnum.Bing.Move(int(cs) % 5 * 2)
+	offset := int(tb.Move(-int(TerrestrialBranchEnum.Yin)))
+	return SexagenaryTerm{
+		CelestialStem:     first.Move(offset),
+		TerrestrialBranch: tb,
+	}
+}
+
+// HourTerm 以该天干为日干时, 地支为tb的时辰的干支(五鼠遁)
+// 甲己还加甲, 乙庚丙作初, 丙辛从戊起, 丁壬庚子居, 戊癸何方发, 壬子是真途
+// 例: x=甲, x.HourTerm(子) -> 甲子
+//     x=戊, x.HourTerm(午) -> 戊午
+func (cs CelestialStem) HourTerm(tb TerrestrialBranch) SexagenaryTerm {
+	// 子时的天干, 五日一循环
+	first := CelestialStemEnum.Jia.Move(int(cs) % 5 * 2)
+	return SexagenaryTerm{
+		CelestialStem:     first.Move(int(tb)),
+		TerrestrialBranch: tb,
+	}
+}
+
+// HourTerms 以该天干为日干时, 当日子时至亥时十二个时辰的干支
+// 例: x=乙, x.HourTerms() -> [丙子, 丁丑, 戊寅, ..., 丁亥]
+func (cs CelestialStem) HourTerms() [12]SexagenaryTerm {
+	var terms [12]SexagenaryTerm
+	for i := range terms {
+		terms[i] = cs.HourTerm(TerrestrialBranch(i))
+	}
+	return terms
+}
+
+// Element 返回该天干的五行
+// 甲乙木, 丙丁火, 戊己土, 庚辛金, 壬癸水
+func (cs CelestialStem) Element() WuXing {
+	if !cs.IsValid() {
+		return -1
+	}
+	return WuXing(cs / 2)
+}
+
+// Polarity 返回该天干的阴阳, 甲丙戊庚壬为阳, 乙丁己辛癸为阴
+func (cs CelestialStem) Polarity() Polarity {
+	if !cs.IsValid() {
+		return -1
+	}
+	return Polarity(cs % 2)
+}
+
 // String 返回天干中文
 func (cs CelestialStem) String() string {
 	if !cs.IsValid() {
@@ -69,6 +69,98 @@ func TestCelestialStem(t *testing.T) {
 		}
 	})
 
+	t.Run("test MonthTerm method", func(t *testing.T) {
+		inputs := []struct {
+			CelestialStem
+			TerrestrialBranch
+		}{
+			{CelestialStemEnum.Jia, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Ji, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Yi, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Bing, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Ren, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Gui, TerrestrialBranchEnum.Yin},
+			{CelestialStemEnum.Jia, TerrestrialBranchEnum.Chou},
+			{CelestialStemEnum.Yi, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Wu, TerrestrialBranchEnum.Shen},
+		}
+		expect := []string{"丙寅", "丙寅", "戊寅", "庚寅", "壬寅", "甲寅", "丁丑", "戊子", "庚申"}
+
+		for idx, each := range inputs {
+			actual := each.CelestialStem.MonthTerm(each.TerrestrialBranch)
+			if actual.String() != expect[idx] {
+				t.Fatalf("month term of %s year in %s month should be %s, got %s",
+					each.CelestialStem,
+					each.TerrestrialBranch,
+					expect[idx],
+					actual,
+				)
+			}
+		}
+	})
+
+	t.Run("test HourTerm method", func(t *testing.T) {
+		inputs := []struct {
+			CelestialStem
+			TerrestrialBranch
+		}{
+			{CelestialStemEnum.Jia, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Ji, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Yi, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Xin, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Ding, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Gui, TerrestrialBranchEnum.Zi},
+			{CelestialStemEnum.Wu, TerrestrialBranchEnum.Wu},
+			{CelestialStemEnum.Gui, TerrestrialBranchEnum.Hai},
+		}
+		expect := []string{"甲子", "甲子", "丙子", "戊子", "庚子", "壬子", "戊午", "癸亥"}
+
+		for idx, each := range inputs {
+			actual := each.CelestialStem.HourTerm(each.TerrestrialBranch)
+			if actual.String() != expect[idx] {
+				t.Fatalf("hour term of %s day in %s hour should be %s, got %s",
+					each.CelestialStem,
+					each.TerrestrialBranch,
+					expect[idx],
+					actual,
+				)
+			}
+		}
+	})
+
+	t.Run("test HourTerms method", func(t *testing.T) {
+		expect := [12]string{"丙子", "丁丑", "戊寅", "己卯", "庚辰", "辛巳", "壬午", "癸未", "甲申", "乙酉", "丙戌", "丁亥"}
+		for idx, each := range CelestialStemEnum.Yi.HourTerms() {
+			if each.String() != expect[idx] {
+				t.Fatalf("hour term %d of %s day should be %s, got %s", idx, CelestialStemEnum.Yi, expect[idx], each)
+			}
+		}
+	})
+
+	t.Run("test Element and Polarity method", func(t *testing.T) {
+		css := [10]CelestialStem{
+			CelestialStemEnum.Jia, CelestialStemEnum.Yi,
+			CelestialStemEnum.Bing, CelestialStemEnum.Ding,
+			CelestialStemEnum.Wu, CelestialStemEnum.Ji,
+			CelestialStemEnum.Geng, CelestialStemEnum.Xin,
+			CelestialStemEnum.Ren, CelestialStemEnum.Gui,
+		}
+		expect := [10]string{"阳木", "阴木", "阳火", "阴火", "阳土", "阴土", "阳金", "阴金", "阳水", "阴水"}
+
+		for idx, each := range css {
+			actual := each.Polarity().String(true) + each.Element().String()
+			if actual != expect[idx] {
+				t.Fatalf("element of %s shoud be %s, got %s", each, expect[idx], actual)
+			}
+		}
+
+		for _, each := range []CelestialStem{CelestialStem(-1), CelestialStem(10), CelestialStem(11)} {
+			if each.Polarity() != -1 || each.Element() != -1 {
+				t.Fatalf("polarity and element of invalid stem %d should be -1, got %d and %d", each, each.Polarity(), each.Element())
+			}
+		}
+	})
+
 	t.Run("test String method", func(t *testing.T) {
 		tbs := [10]CelestialStem{
 			CelestialStemEnum.Jia, CelestialStemEnum.Yi,
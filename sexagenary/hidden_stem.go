@@ -0,0 +1,137 @@
+package sexagenary
+
+// qiTypeWords 藏干气类中文简体
+var qiTypeWords = [3]string{"本气", "中气", "余气"}
+
+// qiTypeWordsTraditional 藏干气类中文繁体
+var qiTypeWordsTraditional = [3]string{"本氣", "中氣", "餘氣"}
+
+// QiType 地支藏干的气类
+// 以人元司令的先后定气类: 交节后先由上一季延续而来的余气当令, 次为中气, 最后为与地支五行相同的本气.
+// 四生(寅申巳亥)的中气为所生之局的五行, 四库(辰戌丑未)的中气为所库之局的五行, 如辰为水库, 中气为癸.
+// 本库只给出气类与司令天数, 不提供各气类的力量权重: 权重的取法各派不一,
+// 需要时可按CommandingStems的当令天数或自定的比例换算
+type QiType int
+
+func (q QiType) String(simplified bool) string {
+	if !q.IsValid() {
+		return ""
+	}
+	if simplified {
+		return qiTypeWords[q]
+	}
+	return qiTypeWordsTraditional[q]
+}
+
+func (q QiType) IsValid() bool {
+	return q >= 0 && q < 3
+}
+
+// QiTypeEnum 藏干气类枚举项
+var QiTypeEnum = struct {
+	Main     QiType // 本气, 与地支五行相同的主气
+	Middle   QiType // 中气, 三合局在该支的气
+	Residual QiType // 余气, 上一季延续而来的气
+}{
+	Main:     0,
+	Middle:   1,
+	Residual: 2,
+}
+
+// HiddenStem 地支所藏的一个天干
+type HiddenStem struct {
+	Stem CelestialStem
+	Qi   QiType
+}
+
+// CommandingStem 人元司令中的一段, 即自月令之节起依次当令的天干及其当令天数
+type CommandingStem struct {
+	Stem CelestialStem
+	Qi   QiType
+	Days int
+}
+
+// hiddenStems 各地支的藏干, 按本气, 中气, 余气排列
+// 与人元司令相比, 子午卯酉亥的余气(壬, 丙, 甲, 庚, 戊)不计入藏干
+var hiddenStems = func() [12][]CelestialStem {
+	cs := CelestialStemEnum
+	return [12][]CelestialStem{
+		{cs.Gui},                  // 子
+		{cs.Ji, cs.Xin, cs.Gui},   // 丑
+		{cs.Jia, cs.Bing, cs.Wu},  // 寅
+		{cs.Yi},                   // 卯
+		{cs.Wu, cs.Gui, cs.Yi},    // 辰
+		{cs.Bing, cs.Geng, cs.Wu}, // 巳
+		{cs.Ding, cs.Ji},          // 午
+		{cs.Ji, cs.Yi, cs.Ding},   // 未
+		{cs.Geng, cs.Ren, cs.Wu},  // 申
+		{cs.Xin},                  // 酉
+		{cs.Wu, cs.Ding, cs.Xin},  // 戌
+		{cs.Ren, cs.Jia},          // 亥
+	}
+}()
+
+// commandingStems 各地支的人元司令, 按自节起当令的先后排列, 即余气, 中气(若有), 本气, 每支合计30日
+var commandingStems = func() [12][]CommandingStem {
+	cs := CelestialStemEnum
+	main, mid, res := QiTypeEnum.Main, QiTypeEnum.Middle, QiTypeEnum.Residual
+	return [12][]CommandingStem{
+		{{cs.Ren, res, 10}, {cs.Gui, main, 20}},                    // 子
+		{{cs.Gui, res, 9}, {cs.Xin, mid, 3}, {cs.Ji, main, 18}},    // 丑
+		{{cs.Wu, res, 7}, {cs.Bing, mid, 7}, {cs.Jia, main, 16}},   // 寅
+		{{cs.Jia, res, 10}, {cs.Yi, main, 20}},                     // 卯
+		{{cs.Yi, res, 9}, {cs.Gui, mid, 3}, {cs.Wu, main, 18}},     // 辰
+		{{cs.Wu, res, 5}, {cs.Geng, mid, 9}, {cs.Bing, main, 16}},  // 巳
+		{{cs.Bing, res, 10}, {cs.Ji, mid, 9}, {cs.Ding, main, 11}}, // 午
+		{{cs.Ding, res, 9}, {cs.Yi, mid, 3}, {cs.Ji, main, 18}},    // 未
+		{{cs.Wu, res, 7}, {cs.Ren, mid, 7}, {cs.Geng, main, 16}},   // 申
+		{{cs.Geng, res, 10}, {cs.Xin, main, 20}},                   // 酉
+		{{cs.Xin, res, 9}, {cs.Ding, mid, 3}, {cs.Wu, main, 18}},   // 戌
+		{{cs.Wu, res, 7}, {cs.Jia, mid, 5}, {cs.Ren, main, 18}},    // 亥
+	}
+}()
+
+// HiddenStems 返回该地支所藏的天干, 按本气, 中气, 余气排列, 无效地支返回nil
+// 只有两个藏干时第二个为中气, 如午藏丁己, 亥藏壬甲
+// 例: x=寅, x.HiddenStems() -> [甲(本气), 丙(中气), 戊(余气)]
+//     x=丑, x.HiddenStems() -> [己(本气), 辛(中气), 癸(余气)]
+//     x=子, x.HiddenStems() -> [癸(本气)]
+func (tb TerrestrialBranch) HiddenStems() []HiddenStem {
+	if !tb.IsValid() {
+		return nil
+	}
+	stems := hiddenStems[tb]
+	result := make([]HiddenStem, len(stems))
+	for i, cs := range stems {
+		result[i] = HiddenStem{Stem: cs, Qi: QiType(i)}
+	}
+	return result
+}
+
+// CommandingStems 返回该地支作为月令时的人元司令, 按自节起当令的先后排列, 无效地支返回nil
+// 例: x=寅, x.CommandingStems() -> [戊(余气)7日, 丙(中气)7日, 甲(本气)16日]
+func (tb TerrestrialBranch) CommandingStems() []CommandingStem {
+	if !tb.IsValid() {
+		return nil
+	}
+	result := make([]CommandingStem, len(commandingStems[tb]))
+	copy(result, commandingStems[tb])
+	return result
+}
+
+// CommandingStemAt 返回该地支作为月令时, 交节后第days日(交节当日为0)当令的天干
+// 超过30日的部分仍由最后一个天干当令; days为负数或地支无效时第二个返回值为false
+// 例: x=寅, x.CommandingStemAt(6) -> 戊, x.CommandingStemAt(7) -> 丙
+func (tb TerrestrialBranch) CommandingStemAt(days int) (CelestialStem, bool) {
+	if !tb.IsValid() || days < 0 {
+		return 0, false
+	}
+	stems := commandingStems[tb]
+	for _, each := range stems {
+		if days < each.Days {
+			return each.Stem, true
+		}
+		days -= each.Days
+	}
+	return stems[len(stems)-1].Stem, true
+}
@@ -0,0 +1,108 @@
+package sexagenary
+
+import "testing"
+
+func TestHiddenStems(t *testing.T) {
+	t.Run("test HiddenStems method", func(t *testing.T) {
+		tbs := [12]TerrestrialBranch{
+			TerrestrialBranchEnum.Zi, TerrestrialBranchEnum.Chou, TerrestrialBranchEnum.Yin, TerrestrialBranchEnum.Mao,
+			TerrestrialBranchEnum.Chen, TerrestrialBranchEnum.Si, TerrestrialBranchEnum.Wu, TerrestrialBranchEnum.Wei,
+			TerrestrialBranchEnum.Shen, TerrestrialBranchEnum.You, TerrestrialBranchEnum.Xu, TerrestrialBranchEnum.Hai,
+		}
+		expect := [12]string{
+			"癸本气",
+			"己本气辛中气癸余气",
+			"甲本气丙中气戊余气",
+			"乙本气",
+			"戊本气癸中气乙余气",
+			"丙本气庚中气戊余气",
+			"丁本气己中气",
+			"己本气乙中气丁余气",
+			"庚本气壬中气戊余气",
+			"辛本气",
+			"戊本气丁中气辛余气",
+			"壬本气甲中气",
+		}
+
+		for idx, each := range tbs {
+			actual := ""
+			for _, hs := range each.HiddenStems() {
+				actual += hs.Stem.String() + hs.Qi.String(true)
+			}
+			if actual != expect[idx] {
+				t.Fatalf("hidden stems of %s should be %s, got %s", each, expect[idx], actual)
+			}
+			if each.HiddenStems()[0].Stem.Element() != each.Element() {
+				t.Fatalf("main qi of %s should have the same element as the branch", each)
+			}
+		}
+
+		if TerrestrialBranch(12).HiddenStems() != nil {
+			t.Fatalf("hidden stems of an invalid branch should be nil")
+		}
+		if QiTypeEnum.Residual.String(false) != "餘氣" {
+			t.Fatalf("traditional name of 余气 should be 餘氣, got %s", QiTypeEnum.Residual.String(false))
+		}
+	})
+
+	t.Run("test CommandingStems method", func(t *testing.T) {
+		for i := 0; i < 12; i++ {
+			tb := TerrestrialBranch(i)
+			days := 0
+			for _, each := range tb.CommandingStems() {
+				days += each.Days
+			}
+			if days != 30 {
+				t.Fatalf("commanding days of %s should sum to 30, got %d", tb, days)
+			}
+			// 最后当令的是本气
+			stems := tb.CommandingStems()
+			if last := stems[len(stems)-1]; last.Stem != tb.HiddenStems()[0].Stem || last.Qi != QiTypeEnum.Main {
+				t.Fatalf("the last commanding stem of %s should be its main qi", tb)
+			}
+			// 同一天干在藏干与人元司令中的气类相同
+			for _, hs := range tb.HiddenStems() {
+				for _, each := range stems {
+					if each.Stem == hs.Stem && each.Qi != hs.Qi {
+						t.Fatalf("%s of %s should be %s in both tables, got %s", hs.Stem, tb, hs.Qi.String(true), each.Qi.String(true))
+					}
+				}
+			}
+		}
+
+		inputs := []struct {
+			TerrestrialBranch
+			Days int
+		}{
+			{TerrestrialBranchEnum.Yin, 0},
+			{TerrestrialBranchEnum.Yin, 6},
+			{TerrestrialBranchEnum.Yin, 7},
+			{TerrestrialBranchEnum.Yin, 14},
+			{TerrestrialBranchEnum.Yin, 31},
+			{TerrestrialBranchEnum.Wu, 19},
+			{TerrestrialBranchEnum.Hai, 11},
+			{TerrestrialBranchEnum.Hai, 12},
+		}
+		expect := []CelestialStem{
+			CelestialStemEnum.Wu,
+			CelestialStemEnum.Wu,
+			CelestialStemEnum.Bing,
+			CelestialStemEnum.Jia,
+			CelestialStemEnum.Jia,
+			CelestialStemEnum.Ding,
+			CelestialStemEnum.Jia,
+			CelestialStemEnum.Ren,
+		}
+
+		for idx, each := range inputs {
+			actual, ok := each.CommandingStemAt(each.Days)
+			if !ok || actual != expect[idx] {
+				t.Fatalf("commanding stem of %s on day %d should be %s, got %s", each.TerrestrialBranch, each.Days, expect[idx], actual)
+			}
+		}
+
+		if _, ok := TerrestrialBranchEnum.Yin.CommandingStemAt(-1); ok {
+			t.Fatalf("commanding stem before the solar term should not be valid")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package sexagenary
+
+import "strings"
+
+// naYinWords 纳音中文简体, 每两个相邻的干支共用一个纳音
+var naYinWords = [30]string{
+	"海中金", "炉中火", "大林木", "路旁土", "剑锋金", "山头火",
+	"涧下水", "城头土", "白蜡金", "杨柳木", "泉中水", "屋上土",
+	"霹雳火", "松柏木", "长流水", "沙中金", "山下火", "平地木",
+	"壁上土", "金箔金", "覆灯火", "天河水", "大驿土", "钗钏金",
+	"桑柘木", "大溪水", "沙中土", "天上火", "石榴木", "大海水",
+}
+
+// naYinWordsTraditional 纳音中文繁体
+var naYinWordsTraditional = [30]string{
+	"海中金", "爐中火", "大林木", "路旁土", "劍鋒金", "山頭火",
+	"澗下水", "城頭土", "白蠟金", "楊柳木", "泉中水", "屋上土",
+	"霹靂火", "松柏木", "長流水", "沙中金", "山下火", "平地木",
+	"壁上土", "金箔金", "覆燈火", "天河水", "大驛土", "釵釧金",
+	"桑柘木", "大溪水", "沙中土", "天上火", "石榴木", "大海水",
+}
+
+// NaYin 纳音五行
+type NaYin int
+
+// NaYin 返回该干支的纳音
+// 例: x=甲子, x.NaYin() -> 海中金
+//     x=癸亥, x.NaYin() -> 大海水
+func (s SexagenaryTerm) NaYin() NaYin {
+	if !s.IsValid() {
+		return -1
+	}
+	return NaYin(s.Index() / 2)
+}
+
+// Element 返回纳音所属的五行, 即纳音名称的末字
+// 例: 海中金 -> 金
+func (n NaYin) Element() WuXing {
+	if !n.IsValid() {
+		return -1
+	}
+	word := naYinWords[n]
+	for idx, w := range wuXingWords {
+		if strings.HasSuffix(word, w) {
+			return WuXing(idx)
+		}
+	}
+	return -1
+}
+
+func (n NaYin) String(simplified bool) string {
+	if !n.IsValid() {
+		return ""
+	}
+	if simplified {
+		return naYinWords[n]
+	}
+	return naYinWordsTraditional[n]
+}
+
+func (n NaYin) IsValid() bool {
+	return n >= 0 && n < 30
+}
+
+// NaYinEnum 纳音枚举项, 按六十甲子的顺序排列
+var NaYinEnum = struct {
+	HaiZhongJin   NaYin // 海中金, 甲子乙丑
+	LuZhongHuo    NaYin // 炉中火, 丙寅丁卯
+	DaLinMu       NaYin // 大林木, 戊辰己巳
+	LuPangTu      NaYin // 路旁土, 庚午辛未
+	JianFengJin   NaYin // 剑锋金, 壬申癸酉
+	ShanTouHuo    NaYin // 山头火, 甲戌乙亥
+	JianXiaShui   NaYin // 涧下水, 丙子丁丑
+	ChengTouTu    NaYin // 城头土, 戊寅己卯
+	BaiLaJin      NaYin // 白蜡金, 庚辰辛巳
+	YangLiuMu     NaYin // 杨柳木, 壬午癸未
+	QuanZhongShui NaYin // 泉中水, 甲申乙酉
+	WuShangTu     NaYin // 屋上土, 丙戌丁亥
+	PiLiHuo       NaYin // 霹雳火, 戊子己丑
+	SongBaiMu     NaYin // 松柏木, 庚寅辛卯
+	ChangLiuShui  NaYin // 长流水, 壬辰癸巳
+	ShaZhongJin   NaYin // 沙中金, 甲午乙未
+	ShanXiaHuo    NaYin // 山下火, 丙申丁酉
+	PingDiMu      NaYin // 平地木, 戊戌己亥
+	BiShangTu     NaYin // 壁上土, 庚子辛丑
+	JinBoJin      NaYin // 金箔金, 壬寅癸卯
+	FuDengHuo     NaYin // 覆灯火, 甲辰乙巳
+	TianHeShui    NaYin // 天河水, 丙午丁未
+	DaYiTu        NaYin // 大驿土, 戊申己酉
+	ChaiChuanJin  NaYin // 钗钏金, 庚戌辛亥
+	SangZheMu     NaYin // 桑柘木, 壬子癸丑
+	DaXiShui      NaYin // 大溪水, 甲寅乙卯
+	ShaZhongTu    NaYin // 沙中土, 丙辰丁巳
+	TianShangHuo  NaYin // 天上火, 戊午己未
+	ShiLiuMu      NaYin // 石榴木, 庚申辛酉
+	DaHaiShui     NaYin // 大海水, 壬戌癸亥
+}{
+	HaiZhongJin:   0,
+	LuZhongHuo:    1,
+	DaLinMu:       2,
+	LuPangTu:      3,
+	JianFengJin:   4,
+	ShanTouHuo:    5,
+	JianXiaShui:   6,
+	ChengTouTu:    7,
+	BaiLaJin:      8,
+	YangLiuMu:     9,
+	QuanZhongShui: 10,
+	WuShangTu:     11,
+	PiLiHuo:       12,
+	SongBaiMu:     13,
+	ChangLiuShui:  14,
+	ShaZhongJin:   15,
+	ShanXiaHuo:    16,
+	PingDiMu:      17,
+	BiShangTu:     18,
+	JinBoJin:      19,
+	FuDengHuo:     20,
+	TianHeShui:    21,
+	DaYiTu:        22,
+	ChaiChuanJin:  23,
+	SangZheMu:     24,
+	DaXiShui:      25,
+	ShaZhongTu:    26,
+	TianShangHuo:  27,
+	ShiLiuMu:      28,
+	DaHaiShui:     29,
+}
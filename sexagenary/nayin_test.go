@@ -0,0 +1,58 @@
+package sexagenary
+
+import "testing"
+
+func TestNaYin(t *testing.T) {
+	t.Run("test NaYin method", func(t *testing.T) {
+		inputs := []SexagenaryTerm{
+			SexagenaryTermEnum.JiaZi,
+			SexagenaryTermEnum.YiChou,
+			SexagenaryTermEnum.BingYin,
+			SexagenaryTermEnum.RenShen,
+			SexagenaryTermEnum.JiaChen,
+			SexagenaryTermEnum.GengXu,
+			SexagenaryTermEnum.GuiHai,
+		}
+		expect := []struct {
+			NaYin
+			Simplified  string
+			Traditional string
+			Element     WuXing
+		}{
+			{NaYinEnum.HaiZhongJin, "海中金", "海中金", WuXingEnum.Metal},
+			{NaYinEnum.HaiZhongJin, "海中金", "海中金", WuXingEnum.Metal},
+			{NaYinEnum.LuZhongHuo, "炉中火", "爐中火", WuXingEnum.Fire},
+			{NaYinEnum.JianFengJin, "剑锋金", "劍鋒金", WuXingEnum.Metal},
+			{NaYinEnum.FuDengHuo, "覆灯火", "覆燈火", WuXingEnum.Fire},
+			{NaYinEnum.ChaiChuanJin, "钗钏金", "釵釧金", WuXingEnum.Metal},
+			{NaYinEnum.DaHaiShui, "大海水", "大海水", WuXingEnum.Water},
+		}
+
+		for idx, each := range inputs {
+			n := each.NaYin()
+			e := expect[idx]
+			if n != e.NaYin || n.String(true) != e.Simplified || n.String(false) != e.Traditional || n.Element() != e.Element {
+				t.Fatalf("na yin of %s should be %s(%s) and %s, got %s(%s) and %s",
+					each,
+					e.Simplified,
+					e.Traditional,
+					e.Element,
+					n.String(true),
+					n.String(false),
+					n.Element(),
+				)
+			}
+		}
+	})
+
+	t.Run("test elements", func(t *testing.T) {
+		// 纳音五行的排列以金火木土金火水土金木水土火木水为一周, 共循环两次
+		expect := "金火木土金火水土金木水土火木水"
+		for n := NaYin(0); n < 30; n++ {
+			e := []rune(expect)[n%15]
+			if n.Element().String() != string(e) {
+				t.Fatalf("element of %s should be %c, got %s", n.String(true), e, n.Element())
+			}
+		}
+	})
+}
@@ -0,0 +1,152 @@
+package sexagenary
+
+import "sort"
+
+// stemRelationTypeWords 天干关系中文简体
+var stemRelationTypeWords = [4]string{"合", "冲", "生", "克"}
+
+// stemRelationTypeWordsTraditional 天干关系中文繁体
+var stemRelationTypeWordsTraditional = [4]string{"合", "沖", "生", "剋"}
+
+// StemRelationType 天干关系的类型
+type StemRelationType int
+
+func (t StemRelationType) String(simplified bool) string {
+	if !t.IsValid() {
+		return ""
+	}
+	if simplified {
+		return stemRelationTypeWords[t]
+	}
+	return stemRelationTypeWordsTraditional[t]
+}
+
+func (t StemRelationType) IsValid() bool {
+	return t >= 0 && t < 4
+}
+
+// StemRelationTypeEnum 天干关系类型枚举项
+var StemRelationTypeEnum = struct {
+	Combination StemRelationType // 五合, 甲己合化土, 乙庚合化金, 丙辛合化水, 丁壬合化木, 戊癸合化火
+	Clash       StemRelationType // 四冲, 甲庚冲, 乙辛冲, 丙壬冲, 丁癸冲, 戊己居中无冲
+	Generation  StemRelationType // 相生, 按天干的五行, 如甲生丙
+	Overcoming  StemRelationType // 相克, 按天干的五行, 如甲克戊
+}{
+	Combination: 0,
+	Clash:       1,
+	Generation:  2,
+	Overcoming:  3,
+}
+
+// StemRelation 两个天干之间的一个关系
+type StemRelation struct {
+	Type StemRelationType
+	// Stems 参与该关系的天干, 合与冲按天干次序排列, 如甲己, 乙庚, 生与克按生者或克者在前排列
+	Stems [2]CelestialStem
+	// Positions Stems中各天干在输入中的位置
+	Positions [2]int
+	// Element 合化的五行, 其他关系为-1
+	Element WuXing
+}
+
+// String 返回该关系的中文
+// 例: 甲己合化土, 甲庚冲, 甲生丙, 庚克甲
+func (r StemRelation) String(simplified bool) string {
+	s := r.Stems[0].String()
+	if r.Type == StemRelationTypeEnum.Combination {
+		return s + r.Stems[1].String() + "合化" + r.Element.String()
+	}
+	if r.Type == StemRelationTypeEnum.Clash {
+		return s + r.Stems[1].String() + r.Type.String(simplified)
+	}
+	return s + r.Type.String(simplified) + r.Stems[1].String()
+}
+
+// NewStemRelations 返回stems中任意两个天干之间的全部关系, 按关系类型排列
+// 例: NewStemRelations(甲, 己) -> [甲己合化土, 甲克己]
+func NewStemRelations(stems ...CelestialStem) []StemRelation {
+	var relations []StemRelation
+	for i := 0; i < len(stems); i++ {
+		for j := i + 1; j < len(stems); j++ {
+			relations = appendStemRelations(relations, stems, i, j)
+		}
+	}
+
+	sort.SliceStable(relations, func(a, b int) bool {
+		return relations[a].Type < relations[b].Type
+	})
+	return relations
+}
+
+// RelationsWith 返回该天干与other之间的全部关系
+func (cs CelestialStem) RelationsWith(other CelestialStem) []StemRelation {
+	return NewStemRelations(cs, other)
+}
+
+// CombinationElement 返回该天干所在五合的合化五行, 无效天干返回-1
+// 例: 甲与己合化土, CelestialStemEnum.Jia.CombinationElement() -> 土
+func (cs CelestialStem) CombinationElement() WuXing {
+	if !cs.IsValid() {
+		return -1
+	}
+	return WuXing((int(cs)%5 + 2) % 5)
+}
+
+// appendStemRelations 将stems中i, j处两个天干之间的关系追加到relations
+func appendStemRelations(relations []StemRelation, stems []CelestialStem, i, j int) []StemRelation {
+	a, b := stems[i], stems[j]
+	if !a.IsValid() || !b.IsValid() {
+		return relations
+	}
+
+	// 合与冲按天干次序排列
+	pi, pj := i, j
+	if a > b {
+		pi, pj = j, i
+	}
+	first, second := stems[pi], stems[pj]
+	if second-first == 5 {
+		relations = append(relations, StemRelation{
+			Type:      StemRelationTypeEnum.Combination,
+			Stems:     [2]CelestialStem{first, second},
+			Positions: [2]int{pi, pj},
+			Element:   first.CombinationElement(),
+		})
+	}
+	if second-first == 6 {
+		relations = append(relations, StemRelation{
+			Type:      StemRelationTypeEnum.Clash,
+			Stems:     [2]CelestialStem{first, second},
+			Positions: [2]int{pi, pj},
+			Element:   -1,
+		})
+	}
+
+	ea, eb := a.Element(), b.Element()
+	directed := []struct {
+		typ  StemRelationType
+		from WuXing
+		to   WuXing
+	}{
+		{StemRelationTypeEnum.Generation, ea.Generates(), eb.Generates()},
+		{StemRelationTypeEnum.Overcoming, ea.Overcomes(), eb.Overcomes()},
+	}
+	for _, each := range directed {
+		if each.from == eb {
+			relations = append(relations, StemRelation{
+				Type:      each.typ,
+				Stems:     [2]CelestialStem{a, b},
+				Positions: [2]int{i, j},
+				Element:   -1,
+			})
+		} else if each.to == ea {
+			relations = append(relations, StemRelation{
+				Type:      each.typ,
+				Stems:     [2]CelestialStem{b, a},
+				Positions: [2]int{j, i},
+				Element:   -1,
+			})
+		}
+	}
+	return relations
+}
@@ -0,0 +1,62 @@
+package sexagenary
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewStemRelations(t *testing.T) {
+	cs := CelestialStemEnum
+	inputs := [][]CelestialStem{
+		{cs.Jia, cs.Ji},
+		{cs.Yi, cs.Geng},
+		{cs.Bing, cs.Xin},
+		{cs.Ding, cs.Ren},
+		{cs.Gui, cs.Wu},
+		{cs.Geng, cs.Jia},
+		{cs.Jia, cs.Bing},
+		{cs.Wu, cs.Jia},
+		{cs.Jia, cs.Yi},
+		{cs.Jia, cs.Ji, cs.Geng},
+		{cs.Jia},
+	}
+	expect := [][]string{
+		{"甲己合化土", "甲克己"},
+		{"乙庚合化金", "庚克乙"},
+		{"丙辛合化水", "丙克辛"},
+		{"丁壬合化木", "壬克丁"},
+		{"戊癸合化火", "戊克癸"},
+		{"甲庚冲", "庚克甲"},
+		{"甲生丙"},
+		{"甲克戊"},
+		{},
+		{"甲己合化土", "甲庚冲", "己生庚", "甲克己", "庚克甲"},
+		{},
+	}
+
+	for idx, each := range inputs {
+		relations := NewStemRelations(each...)
+		actual := make([]string, len(relations))
+		for i, r := range relations {
+			actual[i] = r.String(true)
+		}
+		if strings.Join(actual, ",") != strings.Join(expect[idx], ",") {
+			t.Fatalf("relations of %v should be %v, got %v", each, expect[idx], actual)
+		}
+	}
+
+	t.Run("test positions and element", func(t *testing.T) {
+		r := cs.Ji.RelationsWith(cs.Jia)[0]
+		if r.Type != StemRelationTypeEnum.Combination || r.Element != WuXingEnum.Earth || r.Positions != [2]int{1, 0} {
+			t.Fatalf("己 and 甲 should combine into 土 at [1 0], got %s at %v", r.String(true), r.Positions)
+		}
+
+		r = cs.Xin.RelationsWith(cs.Yi)[1]
+		if r.Type != StemRelationTypeEnum.Overcoming || r.Element.IsValid() || r.Positions != [2]int{0, 1} {
+			t.Fatalf("辛 should overcome 乙 at [0 1], got %s at %v", r.String(true), r.Positions)
+		}
+		if r.String(false) != "辛剋乙" {
+			t.Fatalf("traditional name of %s should be 辛剋乙, got %s", r.String(true), r.String(false))
+		}
+	})
+}
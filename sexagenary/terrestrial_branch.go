@@ -78,6 +78,30 @@ func (tb TerrestrialBranch) Month() int {
 	return int(tb - 1)
 }
 
+// terrestrialBranchElements 各地支的五行
+var terrestrialBranchElements = [12]WuXing{
+	WuXingEnum.Water, WuXingEnum.Earth, WuXingEnum.Wood, WuXingEnum.Wood,
+	WuXingEnum.Earth, WuXingEnum.Fire, WuXingEnum.Fire, WuXingEnum.Earth,
+	WuXingEnum.Metal, WuXingEnum.Metal, WuXingEnum.Earth, WuXingEnum.Water,
+}
+
+// Element 返回该地支的五行
+// 寅卯木, 巳午火, 申酉金, 亥子水, 辰戌丑未土
+func (tb TerrestrialBranch) Element() WuXing {
+	if !tb.IsValid() {
+		return -1
+	}
+	return terrestrialBranchElements[tb]
+}
+
+// Polarity 返回该地支的阴阳, 子寅辰午申戌为阳, 丑卯巳未酉亥为阴
+func (tb TerrestrialBranch) Polarity() Polarity {
+	if !tb.IsValid() {
+		return -1
+	}
+	return Polarity(tb % 2)
+}
+
 // String 返回地支中文
 func (tb TerrestrialBranch) String() string {
 	if !tb.IsValid() {
@@ -204,6 +204,28 @@ func TestTerrestrialBranch(t *testing.T) {
 		}
 	})
 
+	t.Run("test Element and Polarity method", func(t *testing.T) {
+		tbs := [12]TerrestrialBranch{
+			TerrestrialBranchEnum.Zi, TerrestrialBranchEnum.Chou, TerrestrialBranchEnum.Yin, TerrestrialBranchEnum.Mao,
+			TerrestrialBranchEnum.Chen, TerrestrialBranchEnum.Si, TerrestrialBranchEnum.Wu, TerrestrialBranchEnum.Wei,
+			TerrestrialBranchEnum.Shen, TerrestrialBranchEnum.You, TerrestrialBranchEnum.Xu, TerrestrialBranchEnum.Hai,
+		}
+		expect := [12]string{"阳水", "阴土", "阳木", "阴木", "阳土", "阴火", "阳火", "阴土", "阳金", "阴金", "阳土", "阴水"}
+
+		for idx, each := range tbs {
+			actual := each.Polarity().String(true) + each.Element().String()
+			if actual != expect[idx] {
+				t.Fatalf("element of %s shoud be %s, got %s", each, expect[idx], actual)
+			}
+		}
+
+		for _, each := range []TerrestrialBranch{TerrestrialBranch(-1), TerrestrialBranch(12), TerrestrialBranch(13)} {
+			if each.Polarity() != -1 || each.Element() != -1 {
+				t.Fatalf("polarity and element of invalid branch %d should be -1, got %d and %d", each, each.Polarity(), each.Element())
+			}
+		}
+	})
+
 	t.Run("test String method", func(t *testing.T) {
 		tbs := [12]TerrestrialBranch{
 			TerrestrialBranchEnum.Zi, TerrestrialBranchEnum.Chou, TerrestrialBranchEnum.Yin, TerrestrialBranchEnum.Mao,
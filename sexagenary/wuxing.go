@@ -0,0 +1,96 @@
+package sexagenary
+
+// wuXingWords 五行中文
+var wuXingWords = [5]string{"木", "火", "土", "金", "水"}
+
+// WuXing 五行
+type WuXing int
+
+// Generates 返回该五行所生的五行(相生)
+// 木生火, 火生土, 土生金, 金生水, 水生木
+func (w WuXing) Generates() WuXing {
+	return w.move(1)
+}
+
+// GeneratedBy 返回生该五行的五行, Generates的逆操作
+func (w WuXing) GeneratedBy() WuXing {
+	return w.move(-1)
+}
+
+// Overcomes 返回该五行所克的五行(相克)
+// 木克土, 土克水, 水克火, 火克金, 金克木
+func (w WuXing) Overcomes() WuXing {
+	return w.move(2)
+}
+
+// OvercomeBy 返回克该五行的五行, Overcomes的逆操作
+func (w WuXing) OvercomeBy() WuXing {
+	return w.move(-2)
+}
+
+// move 返回按相生的顺序移动nth位后的五行, 该五行无效时返回-1
+func (w WuXing) move(nth int) WuXing {
+	if !w.IsValid() {
+		return -1
+	}
+	return WuXing(((int(w)+nth)%5 + 5) % 5)
+}
+
+// String 返回五行中文
+func (w WuXing) String() string {
+	if !w.IsValid() {
+		return ""
+	}
+	return wuXingWords[w]
+}
+
+func (w WuXing) IsValid() bool {
+	return w >= 0 && w < 5
+}
+
+// WuXingEnum 五行枚举项, 按相生的顺序排列
+var WuXingEnum = struct {
+	Wood  WuXing // 木
+	Fire  WuXing // 火
+	Earth WuXing // 土
+	Metal WuXing // 金
+	Water WuXing // 水
+}{
+	Wood:  0,
+	Fire:  1,
+	Earth: 2,
+	Metal: 3,
+	Water: 4,
+}
+
+// polarityWords 阴阳中文简体
+var polarityWords = [2]string{"阳", "阴"}
+
+// polarityWordsTraditional 阴阳中文繁体
+var polarityWordsTraditional = [2]string{"陽", "陰"}
+
+// Polarity 阴阳
+type Polarity int
+
+func (p Polarity) String(simplified bool) string {
+	if !p.IsValid() {
+		return ""
+	}
+	if simplified {
+		return polarityWords[p]
+	}
+	return polarityWordsTraditional[p]
+}
+
+func (p Polarity) IsValid() bool {
+	return p >= 0 && p < 2
+}
+
+// PolarityEnum 阴阳枚举项
+var PolarityEnum = struct {
+	Yang Polarity // 阳
+	Yin  Polarity // 阴
+}{
+	Yang: 0,
+	Yin:  1,
+}
@@ -0,0 +1,85 @@
+package sexagenary
+
+import "testing"
+
+func TestWuXing(t *testing.T) {
+	elements := [5]WuXing{WuXingEnum.Wood, WuXingEnum.Fire, WuXingEnum.Earth, WuXingEnum.Metal, WuXingEnum.Water}
+
+	t.Run("test relations", func(t *testing.T) {
+		expect := [5]struct {
+			Generates   string
+			GeneratedBy string
+			Overcomes   string
+			OvercomeBy  string
+		}{
+			{"火", "水", "土", "金"},
+			{"土", "木", "金", "水"},
+			{"金", "火", "水", "木"},
+			{"水", "土", "木", "火"},
+			{"木", "金", "火", "土"},
+		}
+
+		for idx, each := range elements {
+			e := expect[idx]
+			if each.Generates().String() != e.Generates || each.GeneratedBy().String() != e.GeneratedBy ||
+				each.Overcomes().String() != e.Overcomes || each.OvercomeBy().String() != e.OvercomeBy {
+				t.Fatalf("relations of %s should be %+v, got %s, %s, %s and %s",
+					each,
+					e,
+					each.Generates(),
+					each.GeneratedBy(),
+					each.Overcomes(),
+					each.OvercomeBy(),
+				)
+			}
+		}
+
+		for _, each := range []WuXing{WuXing(-1), WuXing(5), WuXing(12)} {
+			if each.Generates() != -1 || each.GeneratedBy() != -1 || each.Overcomes() != -1 || each.OvercomeBy() != -1 {
+				t.Fatalf("relations of invalid element %d should be -1, got %d, %d, %d and %d",
+					each,
+					each.Generates(),
+					each.GeneratedBy(),
+					each.Overcomes(),
+					each.OvercomeBy(),
+				)
+			}
+		}
+	})
+
+	t.Run("test String method", func(t *testing.T) {
+		expect := [5]string{"木", "火", "土", "金", "水"}
+		for idx, each := range elements {
+			if each.String() != expect[idx] {
+				t.Fatalf("string of %d shoud be %s, got %s", each, expect[idx], each.String())
+			}
+		}
+		if WuXing(5).String() != "" {
+			t.Fatalf("string of invalid element should be empty")
+		}
+	})
+}
+
+func TestPolarity(t *testing.T) {
+	inputs := []Polarity{PolarityEnum.Yang, PolarityEnum.Yin, Polarity(2)}
+	expect := []struct {
+		Simplified  string
+		Traditional string
+	}{
+		{"阳", "陽"},
+		{"阴", "陰"},
+		{"", ""},
+	}
+
+	for idx, each := range inputs {
+		if each.String(true) != expect[idx].Simplified || each.String(false) != expect[idx].Traditional {
+			t.Fatalf("string of polarity %d should be %s and %s, got %s and %s",
+				each,
+				expect[idx].Simplified,
+				expect[idx].Traditional,
+				each.String(true),
+				each.String(false),
+			)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package sexagenary
+
+// Xun 旬, 六十甲子自每个甲起每十个干支为一旬
+type Xun int
+
+// Xun 返回该干支所在的旬
+// 例: x=丙寅, x.Xun() -> 甲子旬
+//     x=癸亥, x.Xun() -> 甲寅旬
+func (s SexagenaryTerm) Xun() Xun {
+	if !s.IsValid() {
+		return -1
+	}
+	return Xun(s.Index() / 10)
+}
+
+// XunPosition 返回该干支在旬中的位置, 0-9, 即自旬首甲起的序数, 与天干的值相同, 无效干支返回-1
+func (s SexagenaryTerm) XunPosition() int {
+	if !s.IsValid() {
+		return -1
+	}
+	return s.Index() % 10
+}
+
+// VoidBranches 返回该干支所在旬的旬空(空亡)地支
+func (s SexagenaryTerm) VoidBranches() [2]TerrestrialBranch {
+	return s.Xun().VoidBranches()
+}
+
+// Leader 返回旬首的干支, 如甲子旬返回甲子
+func (x Xun) Leader() SexagenaryTerm {
+	return NewSexagenaryTermFromIndex(int(x) * 10)
+}
+
+// VoidBranches 返回该旬的旬空(空亡)地支
+// 一旬十个干支只配得十个地支, 余下的两个地支即为旬空, 为旬首地支之前的两位
+// 例: 甲子旬 -> 戌亥, 甲戌旬 -> 申酉
+// 无效的旬返回两个无效地支
+func (x Xun) VoidBranches() [2]TerrestrialBranch {
+	if !x.IsValid() {
+		return [2]TerrestrialBranch{-1, -1}
+	}
+	tb := x.Leader().TerrestrialBranch
+	return [2]TerrestrialBranch{tb.Move(-2), tb.Move(-1)}
+}
+
+// String 返回旬的中文, 如甲子旬
+func (x Xun) String() string {
+	if !x.IsValid() {
+		return ""
+	}
+	return x.Leader().String() + "旬"
+}
+
+func (x Xun) IsValid() bool {
+	return x >= 0 && x < 6
+}
+
+// XunEnum 旬枚举项
+var XunEnum = struct {
+	JiaZi   Xun // 甲子旬
+	JiaXu   Xun // 甲戌旬
+	JiaShen Xun // 甲申旬
+	JiaWu   Xun // 甲午旬
+	JiaChen Xun // 甲辰旬
+	JiaYin  Xun // 甲寅旬
+}{
+	JiaZi:   0,
+	JiaXu:   1,
+	JiaShen: 2,
+	JiaWu:   3,
+	JiaChen: 4,
+	JiaYin:  5,
+}
@@ -0,0 +1,94 @@
+package sexagenary
+
+import "testing"
+
+func TestXun(t *testing.T) {
+	t.Run("test Xun method", func(t *testing.T) {
+		inputs := []SexagenaryTerm{
+			SexagenaryTermEnum.JiaZi,
+			SexagenaryTermEnum.BingYin,
+			SexagenaryTermEnum.GuiYou,
+			SexagenaryTermEnum.JiaXu,
+			SexagenaryTermEnum.WuZi,
+			SexagenaryTermEnum.GengZi,
+			SexagenaryTermEnum.XinHai,
+			SexagenaryTermEnum.JiaChen,
+			SexagenaryTermEnum.GuiHai,
+		}
+		expect := []struct {
+			Xun
+			Name     string
+			Position int
+			Void     string
+		}{
+			{XunEnum.JiaZi, "甲子旬", 0, "戌亥"},
+			{XunEnum.JiaZi, "甲子旬", 2, "戌亥"},
+			{XunEnum.JiaZi, "甲子旬", 9, "戌亥"},
+			{XunEnum.JiaXu, "甲戌旬", 0, "申酉"},
+			{XunEnum.JiaShen, "甲申旬", 4, "午未"},
+			{XunEnum.JiaWu, "甲午旬", 6, "辰巳"},
+			{XunEnum.JiaChen, "甲辰旬", 7, "寅卯"},
+			{XunEnum.JiaChen, "甲辰旬", 0, "寅卯"},
+			{XunEnum.JiaYin, "甲寅旬", 9, "子丑"},
+		}
+
+		for idx, each := range inputs {
+			e := expect[idx]
+			void := each.VoidBranches()
+			actual := void[0].String() + void[1].String()
+			if each.Xun() != e.Xun || each.Xun().String() != e.Name || each.XunPosition() != e.Position || actual != e.Void {
+				t.Fatalf("xun of %s should be %s at %d with void %s, got %s at %d with void %s",
+					each,
+					e.Name,
+					e.Position,
+					e.Void,
+					each.Xun(),
+					each.XunPosition(),
+					actual,
+				)
+			}
+		}
+
+		invalid := []SexagenaryTerm{
+			{CelestialStem: CelestialStem(10), TerrestrialBranch: TerrestrialBranchEnum.Zi},
+			{CelestialStem: CelestialStemEnum.Jia, TerrestrialBranch: TerrestrialBranch(-1)},
+		}
+		for _, each := range invalid {
+			void := each.VoidBranches()
+			if each.Xun() != -1 || each.XunPosition() != -1 || void[0].IsValid() || void[1].IsValid() {
+				t.Fatalf("xun of invalid term %+v should be -1 at -1 with invalid void, got %d at %d with void %v",
+					each,
+					each.Xun(),
+					each.XunPosition(),
+					void,
+				)
+			}
+		}
+		for _, each := range []Xun{Xun(-1), Xun(6)} {
+			if void := each.VoidBranches(); void[0].IsValid() || void[1].IsValid() {
+				t.Fatalf("void branches of invalid xun %d should be invalid, got %v", each, void)
+			}
+		}
+	})
+
+	t.Run("test Leader method", func(t *testing.T) {
+		xs := [6]Xun{XunEnum.JiaZi, XunEnum.JiaXu, XunEnum.JiaShen, XunEnum.JiaWu, XunEnum.JiaChen, XunEnum.JiaYin}
+		expect := [6]SexagenaryTerm{
+			SexagenaryTermEnum.JiaZi,
+			SexagenaryTermEnum.JiaXu,
+			SexagenaryTermEnum.JiaShen,
+			SexagenaryTermEnum.JiaWu,
+			SexagenaryTermEnum.JiaChen,
+			SexagenaryTermEnum.JiaYin,
+		}
+
+		for idx, each := range xs {
+			if each.Leader() != expect[idx] {
+				t.Fatalf("leader of %s should be %s, got %s", each, expect[idx], each.Leader())
+			}
+		}
+		if Xun(6).String() != "" {
+			t.Fatalf("string of invalid xun should be empty")
+		}
+	})
+}
@@ -4,22 +4,195 @@ import (
 	"time"
 
 	"github.com/hsldymq/go-chinese-calendar/sexagenary"
+	"github.com/hsldymq/go-chinese-calendar/solar"
 )
 
+// SexagenaryTime 干支纪时, 即年,月,日,时四柱
 type SexagenaryTime struct {
 	Year  sexagenary.SexagenaryTerm
 	Month sexagenary.SexagenaryTerm
 	Day   sexagenary.SexagenaryTerm
 	Hour  sexagenary.SexagenaryTerm
+
+	// MonthSolarTerm 开启当前干支月的节
+	MonthSolarTerm solar.SolarTerm
+	// MonthStart MonthSolarTerm的交节时刻
+	MonthStart time.Time
+}
+
+// SexagenaryOptions 干支纪时的计算选项, 零值即为默认选项
+type SexagenaryOptions struct {
+	// Location 日柱与时柱所依据的时区, 为nil时使用东经120°标准时
+	Location *time.Location
+	// YearBoundary 年柱的分界, 默认以立春交节时刻为界
+	YearBoundary YearBoundary
+	// ZiHour 23时至0时(晚子时)的日柱与时柱的处理方式, 默认日柱于0时更替
+	ZiHour ZiHourPolicy
+	// TrueSolarTime 为true时, 日柱与时柱改以Longitude处的真太阳时(地方视时)计算, 此时忽略Location
+	// 真太阳时 = 世界时 + 经度×4分钟 + 时差, 年柱与月柱仍以交节时刻为界, 不受影响
+	TrueSolarTime bool
+	// Longitude 地理经度, 单位度, 东经为正, 西经为负. 仅在TrueSolarTime为true时使用
+	Longitude float64
+}
+
+// YearBoundary 干支年的分界
+type YearBoundary int
+
+func (b YearBoundary) IsValid() bool {
+	return b >= 0 && b < 3
+}
+
+// YearBoundaryEnum 干支年分界枚举项
+var YearBoundaryEnum = struct {
+	BeginningOfSpring    YearBoundary // 立春交节时刻, 八字排盘通常采用此分界
+	BeginningOfSpringDay YearBoundary // 立春当日零点
+	LunarNewYear         YearBoundary // 正月初一零点, 即sexagenaryYearBase所采用的分界
+}{
+	BeginningOfSpring:    0,
+	BeginningOfSpringDay: 1,
+	LunarNewYear:         2,
+}
+
+// ZiHourPolicy 子时换日的处理方式
+// 子时跨越23时至1时, 各派对23时至0时(晚子时)属于哪一日说法不一
+type ZiHourPolicy int
+
+func (p ZiHourPolicy) IsValid() bool {
+	return p >= 0 && p < 3
 }
 
+// ZiHourPolicyEnum 子时换日方式枚举项
+var ZiHourPolicyEnum = struct {
+	Midnight    ZiHourPolicy // 日柱于0时更替, 晚子时仍用当日日干起时干. 例: 甲日23时为甲日甲子时
+	ZiHourStart ZiHourPolicy // 日柱于23时(子初)更替, 晚子时即次日的子时. 例: 甲日23时为乙日丙子时
+	EarlyLateZi ZiHourPolicy // 区分早晚子时, 日柱于0时更替, 晚子时用次日日干起时干. 例: 甲日23时为甲日丙子时
+}{
+	Midnight:    0,
+	ZiHourStart: 1,
+	EarlyLateZi: 2,
+}
+
+// NewSexagenaryTime 根据时间计算其干支四柱
+// 年柱以立春交节时刻为界, 月柱以十二节交节时刻为界, 日柱以Timezone所在时区的零点为界
+// Timezone不传时, 默认为东经120°标准时
 func NewSexagenaryTime(t time.Time, Timezone ...*time.Location) SexagenaryTime {
-	tz := baseTimezone
+	opts := SexagenaryOptions{}
 	if len(Timezone) > 0 {
-		tz = Timezone[0]
+		opts.Location = Timezone[0]
+	}
+	return NewSexagenaryTimeWithOptions(t, opts)
+}
+
+// NewSexagenaryTimeWithOptions 根据时间与计算选项计算其干支四柱
+// 例: 2024-02-06 12:00处于立春之后, 正月初一之前,
+// 年柱以立春为界时为甲辰, 以正月初一为界时为癸卯, 月柱均为丙寅
+func NewSexagenaryTimeWithOptions(t time.Time, opts SexagenaryOptions) SexagenaryTime {
+	tz := opts.Location
+	if tz == nil {
+		tz = baseTimezone
 	}
-	t.In(tz)
-	st := SexagenaryTime{}
+	t = t.In(tz)
 
-	return st
+	year, jie, jieStart := solarMonthOf(t)
+	month := sexagenary.NewSexagenaryTermFromIndex(year).CelestialStem.MonthTerm(jieBranch(jie))
+	switch opts.YearBoundary {
+	case YearBoundaryEnum.BeginningOfSpringDay:
+		year = sexagenaryYearIndexByDay(t)
+	case YearBoundaryEnum.LunarNewYear:
+		year = NewLunarDateFromTime(t).Year - sexagenaryYearBase.Year()
+	}
+	// clock 日柱与时柱所依据的钟面时间
+	clock := t
+	if opts.TrueSolarTime {
+		clock = t.In(trueSolarZone(t, opts.Longitude))
+	}
+	day := sexagenaryDayIndex(clock)
+	branch := sexagenary.NewTerrestrialBranchFromTime(clock)
+	// 起时干所用的日干
+	hourDay := day
+	if clock.Hour() == 23 {
+		switch opts.ZiHour {
+		case ZiHourPolicyEnum.ZiHourStart:
+			day++
+			hourDay = day
+		case ZiHourPolicyEnum.EarlyLateZi:
+			hourDay = day + 1
+		}
+	}
+
+	return SexagenaryTime{
+		Year:  sexagenary.NewSexagenaryTermFromIndex(year),
+		Month: month,
+		Day:   sexagenary.NewSexagenaryTermFromIndex(day),
+		Hour:  sexagenary.NewSexagenaryTermFromIndex(hourDay).CelestialStem.HourTerm(branch),
+
+		MonthSolarTerm: jie,
+		MonthStart:     jieStart.In(t.Location()),
+	}
+}
+
+// trueSolarZone 返回以t时刻经度longitude处真太阳时与世界时之差为偏移的时区
+// 例: 乌鲁木齐(东经87.6°)北京时间12:00, 地方平时约为09:50, 计入6月初约+2分钟的时差后, 真太阳时约为09:52
+func trueSolarZone(t time.Time, longitude float64) *time.Location {
+	offset := time.Duration(longitude*4*float64(time.Minute)) + solar.EquationOfTime(t)
+	return time.FixedZone("LAT", int(offset.Round(time.Second)/time.Second))
+}
+
+// sexagenaryYearIndexByDay 返回以立春当日零点为界时, t所在干支年的索引值
+func sexagenaryYearIndexByDay(t time.Time) int {
+	year := t.Year()
+	y, m, d := solar.SolarTermEnum.TheBeginningOfSpring.Time(year).In(t.Location()).Date()
+	if t.Before(time.Date(y, m, d, 0, 0, 0, 0, t.Location())) {
+		year--
+	}
+	return year - sexagenaryYearBase.Year()
+}
+
+// solarMonthOf 返回t所处的干支月(节月)
+// 返回值依次为: 以立春交节时刻为界的干支年索引, 开启该月的节, 以及该节的交节时刻
+func solarMonthOf(t time.Time) (int, solar.SolarTerm, time.Time) {
+	jie := solar.ApparentLongitude(t).SolarTerm()
+	if jie.IsMidTerm() {
+		jie = jie.Move(-1)
+	}
+
+	year := t.Year()
+	start := jie.Time(year)
+	// 交节时刻与t相差一天以上, 说明该节在上一个公历年, 如t为1月初时的大雪
+	if start.Sub(t) > 24*time.Hour {
+		start = jie.Time(year - 1)
+	}
+
+	// 公历1,2月处于子月,丑月时, 尚未交立春, 仍属上一干支年
+	if t.Month() <= time.February && jieBranch(jie) <= sexagenary.TerrestrialBranchEnum.Chou {
+		year--
+	}
+
+	return year - sexagenaryYearBase.Year(), jie, start
+}
+
+// jieBranch 返回节所开启的月的地支, 立春开启寅月, 惊蛰开启卯月, 以此类推
+func jieBranch(jie solar.SolarTerm) sexagenary.TerrestrialBranch {
+	offset := int(jie.Move(-int(solar.SolarTermEnum.TheBeginningOfSpring))) / 2
+	return sexagenary.TerrestrialBranchEnum.Yin.Move(offset)
+}
+
+// sexagenaryDayIndex 返回t所在日期相对于sexagenaryDayBase的干支日索引值
+func sexagenaryDayIndex(t time.Time) int {
+	y, m, d := t.Date()
+	by, bm, bd := sexagenaryDayBase.Date()
+	days := floorDiv(
+		time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()-time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Unix(),
+		24*60*60,
+	)
+
+	return int((days%60 + 60) % 60)
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q
 }
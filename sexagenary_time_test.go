@@ -1,7 +1,181 @@
 package calendar
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/solar"
+)
 
 func TestNewSexagenaryTime(t *testing.T) {
+	inputs := []time.Time{
+		time.Date(2008, 8, 8, 20, 0, 0, 0, baseTimezone),
+		time.Date(2000, 1, 1, 12, 0, 0, 0, baseTimezone),
+		time.Date(1984, 2, 5, 0, 30, 0, 0, baseTimezone),
+		time.Date(2024, 2, 4, 15, 30, 0, 0, baseTimezone),
+		time.Date(2024, 2, 4, 17, 30, 0, 0, baseTimezone),
+		time.Date(1949, 10, 1, 10, 0, 0, 0, baseTimezone),
+		time.Date(2008, 8, 8, 12, 0, 0, 0, time.UTC),
+	}
+	expect := [][4]string{
+		{"戊子", "庚申", "庚辰", "丙戌"},
+		{"己卯", "丙子", "戊午", "戊午"},
+		{"甲子", "丙寅", "己巳", "甲子"},
+		{"癸卯", "乙丑", "戊戌", "庚申"},
+		{"甲辰", "丙寅", "戊戌", "辛酉"},
+		{"己丑", "癸酉", "甲子", "己巳"},
+		{"戊子", "庚申", "庚辰", "壬午"},
+	}
+
+	for idx, each := range inputs {
+		tz := each.Location()
+		st := NewSexagenaryTime(each, tz)
+		actual := [4]string{st.Year.String(), st.Month.String(), st.Day.String(), st.Hour.String()}
+		if actual != expect[idx] {
+			t.Fatalf("sexagenary time of %s should be %v, got %v", each, expect[idx], actual)
+		}
+	}
+}
+
+func TestNewSexagenaryTimeWithOptions(t *testing.T) {
+	t.Run("test YearBoundary option", func(t *testing.T) {
+		// 2024年立春为2月4日16:27, 正月初一为2月10日
+		inputs := []time.Time{
+			time.Date(2024, 2, 4, 8, 0, 0, 0, baseTimezone),
+			time.Date(2024, 2, 4, 17, 0, 0, 0, baseTimezone),
+			time.Date(2024, 2, 6, 12, 0, 0, 0, baseTimezone),
+			time.Date(2024, 2, 10, 0, 0, 0, 0, baseTimezone),
+			time.Date(2024, 2, 3, 23, 0, 0, 0, baseTimezone),
+		}
+		boundaries := []YearBoundary{
+			YearBoundaryEnum.BeginningOfSpring,
+			YearBoundaryEnum.BeginningOfSpringDay,
+			YearBoundaryEnum.LunarNewYear,
+		}
+		expect := [][3]string{
+			{"癸卯", "甲辰", "癸卯"},
+			{"甲辰", "甲辰", "癸卯"},
+			{"甲辰", "甲辰", "癸卯"},
+			{"甲辰", "甲辰", "甲辰"},
+			{"癸卯", "癸卯", "癸卯"},
+		}
+
+		for idx, each := range inputs {
+			for bIdx, boundary := range boundaries {
+				st := NewSexagenaryTimeWithOptions(each, SexagenaryOptions{YearBoundary: boundary})
+				if st.Year.String() != expect[idx][bIdx] {
+					t.Fatalf("year of %s with boundary %d should be %s, got %s", each, boundary, expect[idx][bIdx], st.Year)
+				}
+				if def := NewSexagenaryTime(each); st.Month != def.Month || st.Day != def.Day || st.Hour != def.Hour {
+					t.Fatalf("year boundary should not affect other pillars of %s", each)
+				}
+			}
+		}
+	})
+}
+
+func TestSexagenaryTimeZiHour(t *testing.T) {
+	// 2024年2月9日为癸卯日, 2月10日为甲辰日
+	inputs := []time.Time{
+		time.Date(2024, 2, 9, 22, 30, 0, 0, baseTimezone),
+		time.Date(2024, 2, 9, 23, 30, 0, 0, baseTimezone),
+		time.Date(2024, 2, 10, 0, 30, 0, 0, baseTimezone),
+	}
+	policies := []ZiHourPolicy{
+		ZiHourPolicyEnum.Midnight,
+		ZiHourPolicyEnum.ZiHourStart,
+		ZiHourPolicyEnum.EarlyLateZi,
+	}
+	expect := [][3][2]string{
+		{{"癸卯", "癸亥"}, {"癸卯", "癸亥"}, {"癸卯", "癸亥"}},
+		{{"癸卯", "壬子"}, {"甲辰", "甲子"}, {"癸卯", "甲子"}},
+		{{"甲辰", "甲子"}, {"甲辰", "甲子"}, {"甲辰", "甲子"}},
+	}
+
+	for idx, each := range inputs {
+		for pIdx, policy := range policies {
+			st := NewSexagenaryTimeWithOptions(each, SexagenaryOptions{ZiHour: policy})
+			actual := [2]string{st.Day.String(), st.Hour.String()}
+			if actual != expect[idx][pIdx] {
+				t.Fatalf("day and hour of %s with policy %d should be %v, got %v", each, policy, expect[idx][pIdx], actual)
+			}
+		}
+	}
+}
+
+func TestSexagenaryTimeTrueSolarTime(t *testing.T) {
+	inputs := []struct {
+		time.Time
+		Longitude float64
+	}{
+		// 乌鲁木齐, 北京时间12:00, 真太阳时约09:52
+		{time.Date(2024, 6, 1, 12, 0, 0, 0, baseTimezone), 87.6},
+		// 乌鲁木齐, 北京时间01:00, 真太阳时约为前一日22:53
+		{time.Date(2024, 6, 1, 1, 0, 0, 0, baseTimezone), 87.6},
+		// 哈尔滨, 北京时间10:20, 真太阳时约11:03
+		{time.Date(2024, 11, 3, 10, 20, 0, 0, baseTimezone), 126.6},
+		// 东经120°, 北京时间12:00, 仅有时差的影响
+		{time.Date(2024, 6, 1, 12, 0, 0, 0, baseTimezone), 120},
+	}
+	expect := []struct {
+		Standard  [2]string
+		TrueSolar [2]string
+	}{
+		{[2]string{"丙申", "甲午"}, [2]string{"丙申", "癸巳"}},
+		{[2]string{"丙申", "己丑"}, [2]string{"乙未", "丁亥"}},
+		{[2]string{"辛未", "癸巳"}, [2]string{"辛未", "甲午"}},
+		{[2]string{"丙申", "甲午"}, [2]string{"丙申", "甲午"}},
+	}
+
+	for idx, each := range inputs {
+		st := NewSexagenaryTime(each.Time)
+		actual := [2]string{st.Day.String(), st.Hour.String()}
+		if actual != expect[idx].Standard {
+			t.Fatalf("day and hour of %s should be %v, got %v", each.Time, expect[idx].Standard, actual)
+		}
+
+		opts := SexagenaryOptions{TrueSolarTime: true, Longitude: each.Longitude}
+		st = NewSexagenaryTimeWithOptions(each.Time, opts)
+		actual = [2]string{st.Day.String(), st.Hour.String()}
+		if actual != expect[idx].TrueSolar {
+			t.Fatalf("true solar day and hour of %s at %.1f°E should be %v, got %v", each.Time, each.Longitude, expect[idx].TrueSolar, actual)
+		}
+	}
+}
+
+func TestSexagenaryTimeMonth(t *testing.T) {
+	inputs := []time.Time{
+		time.Date(2024, 2, 6, 12, 0, 0, 0, baseTimezone),
+		time.Date(2024, 1, 2, 12, 0, 0, 0, baseTimezone),
+		time.Date(2024, 1, 20, 12, 0, 0, 0, baseTimezone),
+		time.Date(2024, 3, 20, 12, 0, 0, 0, baseTimezone),
+		time.Date(2024, 12, 31, 12, 0, 0, 0, baseTimezone),
+	}
+	expect := []struct {
+		Month string
+		solar.SolarTerm
+		Year int
+	}{
+		{"丙寅", solar.SolarTermEnum.TheBeginningOfSpring, 2024},
+		{"甲子", solar.SolarTermEnum.GreaterSnow, 2023},
+		{"乙丑", solar.SolarTermEnum.LesserCold, 2024},
+		{"丁卯", solar.SolarTermEnum.TheWakingOfInsects, 2024},
+		{"丙子", solar.SolarTermEnum.GreaterSnow, 2024},
+	}
 
+	for idx, each := range inputs {
+		st := NewSexagenaryTime(each)
+		e := expect[idx]
+		if st.Month.String() != e.Month || st.MonthSolarTerm != e.SolarTerm || !st.MonthStart.Equal(e.SolarTerm.Time(e.Year)) {
+			t.Fatalf("month of %s should be %s opened by %s at %s, got %s opened by %s at %s",
+				each,
+				e.Month,
+				e.SolarTerm.String(true),
+				e.SolarTerm.Time(e.Year),
+				st.Month,
+				st.MonthSolarTerm.String(true),
+				st.MonthStart,
+			)
+		}
+	}
 }
@@ -0,0 +1,46 @@
+package solar
+
+import (
+	"math"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// EquationOfTime 返回t时刻的时差(真太阳时 - 平太阳时)
+// 例: 11月初时差约为+16分, 即真太阳时比平太阳时快约16分钟; 2月中旬约为-14分
+func EquationOfTime(t time.Time) time.Duration {
+	minutes := equationOfTime(astro.JulianEphemerisDay(t))
+	return time.Duration(minutes * float64(time.Minute))
+}
+
+// equationOfTime 返回儒略历书日jde时的时差, 单位分钟
+// 采用Meeus第28章的方法: E = L0 - 0.0057183° - α + Δψ·cos ε
+func equationOfTime(jde float64) float64 {
+	tau := (jde - astro.J2000) / 365250
+	t := tau * 10
+
+	// 太阳平黄经
+	l0 := astro.Poly(tau, 280.4664567, 360007.6982779, 0.03032028, 1.0/49931, -1.0/15300, -1.0/2000000)
+
+	dpsi, deps := nutation(t)
+	eps := (meanObliquity(t) + deps) * math.Pi / 180
+
+	_, lat, _ := geometricPosition(tau)
+	lon := apparentLongitude(jde) * math.Pi / 180
+	beta := lat * math.Pi / 180
+	alpha := math.Atan2(math.Sin(lon)*math.Cos(eps)-math.Tan(beta)*math.Sin(eps), math.Cos(lon)) * 180 / math.Pi
+
+	e := l0 - 0.0057183 - alpha + dpsi*math.Cos(eps)
+	e = math.Mod(e+180, 360)
+	if e < 0 {
+		e += 360
+	}
+	// 1度对应4分钟
+	return (e - 180) * 4
+}
+
+// meanObliquity 返回儒略世纪数t(力学时)时的平黄赤交角, 单位度
+func meanObliquity(t float64) float64 {
+	return astro.Poly(t, 84381.448, -46.8150, -0.00059, 0.001813) / 3600
+}
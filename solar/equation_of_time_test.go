@@ -0,0 +1,41 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEquationOfTime(t *testing.T) {
+	t.Run("test against Meeus example 28.a", func(t *testing.T) {
+		// 1992年10月13日0时(力学时), 时差13分42.6秒
+		expect := 13 + 42.6/60
+		actual := equationOfTime(2448908.5)
+		if math.Abs(actual-expect)*60 > 0.5 {
+			t.Fatalf("equation of time at JDE 2448908.5 should be %.4f minutes, got %.4f", expect, actual)
+		}
+	})
+
+	t.Run("test extremes of the year", func(t *testing.T) {
+		inputs := []time.Time{
+			time.Date(2024, 2, 11, 12, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 14, 12, 0, 0, 0, time.UTC),
+			time.Date(2024, 7, 26, 12, 0, 0, 0, time.UTC),
+			time.Date(2024, 11, 3, 12, 0, 0, 0, time.UTC),
+		}
+		// 全年时差的四个极值, 精确到分钟
+		expect := []time.Duration{
+			-14 * time.Minute,
+			4 * time.Minute,
+			-7 * time.Minute,
+			16 * time.Minute,
+		}
+
+		for idx, each := range inputs {
+			actual := EquationOfTime(each).Round(time.Minute)
+			if actual != expect[idx] {
+				t.Fatalf("equation of time at %s should be about %s, got %s", each, expect[idx], EquationOfTime(each))
+			}
+		}
+	})
+}
@@ -0,0 +1,70 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// SolarTermIterator 按时间顺序遍历一段时间内的每一次交节
+// 每个节气的求解都以上一个节气的时刻为初值, 通常一两次迭代即可收敛
+// 例: it := NewSolarTermIterator(from, to)
+//     for it.Next() {
+//         fmt.Println(it.SolarTerm().String(true), it.Time())
+//     }
+type SolarTermIterator struct {
+	term    SolarTerm
+	jde     float64
+	fromJDE float64
+	toJDE   float64
+	time    time.Time
+	started bool
+	done    bool
+}
+
+// NewSolarTermIterator 创建遍历[from, to)之间所有交节时刻的迭代器
+func NewSolarTermIterator(from, to time.Time) *SolarTermIterator {
+	fromJDE := astro.JulianEphemerisDay(from)
+	l := apparentLongitude(fromJDE)
+	term := EclipticLongitude(l).SolarTerm()
+	offset := l - float64(term)*15
+
+	// 从from所处节气的交节时刻起算
+	return &SolarTermIterator{
+		term:    term,
+		jde:     solveLongitude(float64(term)*15, fromJDE-offset*tropicalYear/360),
+		fromJDE: fromJDE,
+		toJDE:   astro.JulianEphemerisDay(to),
+	}
+}
+
+// Next 推进到下一次交节, 超出范围时返回false
+func (it *SolarTermIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	// 首次调用时, from所处节气的交节时刻若不早于from(即恰好在from交节), 则直接返回该节气
+	if it.started || it.jde < it.fromJDE {
+		it.term = it.term.Move(1)
+		it.jde = solveLongitude(float64(it.term)*15, it.jde+tropicalYear/24)
+	}
+	it.started = true
+
+	if it.jde >= it.toJDE {
+		it.done = true
+		return false
+	}
+	it.time = astro.TimeFromJulianEphemerisDay(it.jde)
+	return true
+}
+
+// SolarTerm 返回当前所处的节气
+func (it *SolarTermIterator) SolarTerm() SolarTerm {
+	return it.term
+}
+
+// Time 返回当前节气的交节时刻(UTC)
+func (it *SolarTermIterator) Time() time.Time {
+	return it.time
+}
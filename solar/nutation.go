@@ -0,0 +1,64 @@
+package solar
+
+import (
+	"math"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// nutationTerm IAU 1980章动理论的一项
+// 前5项为D, M, M', F, Ω的系数, 后4项为黄经章动与交角章动的正弦/余弦振幅及其变率, 单位0.0001"
+type nutationTerm [9]float64
+
+// nutationTerms 振幅不小于0.0015"的章动项, 截断误差约0.01"
+var nutationTerms = []nutationTerm{
+	{0, 0, 0, 0, 1, -171996, -174.2, 92025, 8.9},
+	{-2, 0, 0, 2, 2, -13187, -1.6, 5736, -3.1},
+	{0, 0, 0, 2, 2, -2274, -0.2, 977, -0.5},
+	{0, 0, 0, 0, 2, 2062, 0.2, -895, 0.5},
+	{0, 1, 0, 0, 0, 1426, -3.4, 54, -0.1},
+	{0, 0, 1, 0, 0, 712, 0.1, -7, 0},
+	{-2, 1, 0, 2, 2, -517, 1.2, 224, -0.6},
+	{0, 0, 0, 2, 1, -386, -0.4, 200, 0},
+	{0, 0, 1, 2, 2, -301, 0, 129, -0.1},
+	{-2, -1, 0, 2, 2, 217, -0.5, -95, 0.3},
+	{-2, 0, 1, 0, 0, -158, 0, 0, 0},
+	{-2, 0, 0, 2, 1, 129, 0.1, -70, 0},
+	{0, 0, -1, 2, 2, 123, 0, -53, 0},
+	{2, 0, 0, 0, 0, 63, 0, 0, 0},
+	{0, 0, 1, 0, 1, 63, 0.1, -33, 0},
+	{2, 0, -1, 2, 2, -59, 0, 26, 0},
+	{0, 0, -1, 0, 1, -58, -0.1, 32, 0},
+	{0, 0, 1, 2, 1, -51, 0, 27, 0},
+	{-2, 0, 2, 0, 0, 48, 0, 0, 0},
+	{0, 0, -2, 2, 1, 46, 0, -24, 0},
+	{2, 0, 0, 2, 2, -38, 0, 16, 0},
+	{0, 0, 2, 2, 2, -31, 0, 13, 0},
+	{0, 0, 2, 0, 0, 29, 0, 0, 0},
+	{-2, 0, 1, 2, 2, 29, 0, -12, 0},
+	{0, 0, 0, 2, 0, 26, 0, 0, 0},
+	{-2, 0, 0, 2, 0, -22, 0, 0, 0},
+	{0, 0, -1, 2, 1, 21, 0, -10, 0},
+	{0, 2, 0, 0, 0, 17, -0.1, 0, 0},
+	{2, 0, -1, 0, 1, 16, 0, -8, 0},
+	{-2, 2, 0, 2, 2, -16, 0.1, 7, 0},
+	{0, 1, 0, 0, 1, -15, 0, 9, 0},
+}
+
+// nutation 返回儒略世纪数t(力学时, 自J2000.0起算)时的黄经章动Δψ与交角章动Δε, 单位度
+func nutation(t float64) (float64, float64) {
+	d := astro.Poly(t, 297.85036, 445267.111480, -0.0019142, 1.0/189474) * math.Pi / 180
+	m := astro.Poly(t, 357.52772, 35999.050340, -0.0001603, -1.0/300000) * math.Pi / 180
+	mp := astro.Poly(t, 134.96298, 477198.867398, 0.0086972, 1.0/56250) * math.Pi / 180
+	f := astro.Poly(t, 93.27191, 483202.017538, -0.0036825, 1.0/327270) * math.Pi / 180
+	omega := astro.Poly(t, 125.04452, -1934.136261, 0.0020708, 1.0/450000) * math.Pi / 180
+
+	var dpsi, deps float64
+	for _, n := range nutationTerms {
+		arg := n[0]*d + n[1]*m + n[2]*mp + n[3]*f + n[4]*omega
+		dpsi += (n[5] + n[6]*t) * math.Sin(arg)
+		deps += (n[7] + n[8]*t) * math.Cos(arg)
+	}
+
+	return dpsi / 36e6, deps / 36e6
+}
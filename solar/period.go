@@ -0,0 +1,61 @@
+package solar
+
+import (
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// pentadDuration 每候的天数, 末候包含节气剩余的时间
+const pentadDuration = 5 * 24 * time.Hour
+
+// SolarTermPeriod 某一时刻所处的节气及其起止时刻
+type SolarTermPeriod struct {
+	// SolarTerm 所处的节气
+	SolarTerm SolarTerm
+	// Pentad 所处的候
+	Pentad Pentad
+	// Start 该节气的交节时刻(UTC)
+	Start time.Time
+	// End 下一个节气的交节时刻(UTC)
+	End time.Time
+}
+
+// NewSolarTermPeriodFromTime 返回t时刻所处的节气与候
+// 例: t=2024-04-25 00:00 UTC, 谷雨于2024-04-19 13:59 UTC交节, 已过5日余, 处于谷雨次候
+func NewSolarTermPeriodFromTime(t time.Time) SolarTermPeriod {
+	jde := astro.JulianEphemerisDay(t)
+	l := apparentLongitude(jde)
+	st := EclipticLongitude(l).SolarTerm()
+
+	startJDE := solveLongitude(float64(st)*15, jde-(l-float64(st)*15)*tropicalYear/360)
+	endJDE := solveLongitude(float64(st.Move(1))*15, startJDE+tropicalYear/24)
+
+	p := SolarTermPeriod{
+		SolarTerm: st,
+		Start:     astro.TimeFromJulianEphemerisDay(startJDE),
+		End:       astro.TimeFromJulianEphemerisDay(endJDE),
+	}
+	p.Pentad = p.pentadOf(t)
+
+	return p
+}
+
+// PentadStart 返回该节气中某一候的起始时刻(UTC)
+func (p SolarTermPeriod) PentadStart(pentad Pentad) time.Time {
+	if !pentad.IsValid() {
+		return time.Time{}
+	}
+	return p.Start.Add(time.Duration(pentad) * pentadDuration)
+}
+
+// pentadOf 返回t处于该节气的第几候
+func (p SolarTermPeriod) pentadOf(t time.Time) Pentad {
+	pentad := Pentad(t.Sub(p.Start) / pentadDuration)
+	if pentad < FirstPentad {
+		return FirstPentad
+	} else if pentad > ThirdPentad {
+		return ThirdPentad
+	}
+	return pentad
+}
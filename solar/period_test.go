@@ -0,0 +1,56 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewSolarTermPeriodFromTime(t *testing.T) {
+	inputs := []time.Time{
+		time.Date(2024, 4, 19, 15, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 19, 13, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 20, 3, 7, 0, 0, time.UTC),
+	}
+	expect := []struct {
+		SolarTerm
+		Pentad
+	}{
+		{SolarTermEnum.GrainRain, FirstPentad},
+		{SolarTermEnum.GrainRain, SecondPentad},
+		{SolarTermEnum.GrainRain, ThirdPentad},
+		{SolarTermEnum.PureBrightness, ThirdPentad},
+		{SolarTermEnum.TheSpringEquinox, FirstPentad},
+	}
+
+	for idx, each := range inputs {
+		p := NewSolarTermPeriodFromTime(each)
+		if p.SolarTerm != expect[idx].SolarTerm || p.Pentad != expect[idx].Pentad {
+			t.Fatalf("%s should be in %s%s, got %s%s",
+				each,
+				expect[idx].SolarTerm.String(true),
+				expect[idx].Pentad,
+				p.SolarTerm.String(true),
+				p.Pentad,
+			)
+		}
+		if p.Start.After(each) || !p.End.After(each) {
+			t.Fatalf("%s should be between %s and %s", each, p.Start, p.End)
+		}
+		if diff := p.Start.Sub(p.SolarTerm.Time(2024)); diff > time.Second || diff < -time.Second {
+			t.Fatalf("%s should begin at %s, got %s", p.SolarTerm.String(true), p.SolarTerm.Time(2024), p.Start)
+		}
+	}
+}
+
+func TestPentad(t *testing.T) {
+	inputs := []Pentad{FirstPentad, SecondPentad, ThirdPentad, -1, 3}
+	expect := []string{"初候", "次候", "末候", "", ""}
+
+	for idx, each := range inputs {
+		if actual := each.String(); actual != expect[idx] {
+			t.Fatalf("string of %d should be %s, got %s", each, expect[idx], actual)
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package solar
+
+// phenologies 七十二候中文简体, 按节气顺序(自春分起)每个节气三候
+var phenologies = [24][3]string{
+	{"玄鸟至", "雷乃发声", "始电"},
+	{"桐始华", "田鼠化为鴽", "虹始见"},
+	{"萍始生", "鸣鸠拂其羽", "戴胜降于桑"},
+	{"蝼蝈鸣", "蚯蚓出", "王瓜生"},
+	{"苦菜秀", "靡草死", "麦秋至"},
+	{"螳螂生", "鵙始鸣", "反舌无声"},
+	{"鹿角解", "蜩始鸣", "半夏生"},
+	{"温风至", "蟋蟀居壁", "鹰始挚"},
+	{"腐草为萤", "土润溽暑", "大雨时行"},
+	{"凉风至", "白露降", "寒蝉鸣"},
+	{"鹰乃祭鸟", "天地始肃", "禾乃登"},
+	{"鸿雁来", "玄鸟归", "群鸟养羞"},
+	{"雷始收声", "蛰虫坯户", "水始涸"},
+	{"鸿雁来宾", "雀入大水为蛤", "菊有黄华"},
+	{"豺乃祭兽", "草木黄落", "蛰虫咸俯"},
+	{"水始冰", "地始冻", "雉入大水为蜃"},
+	{"虹藏不见", "天气上升地气下降", "闭塞而成冬"},
+	{"鹖鴠不鸣", "虎始交", "荔挺出"},
+	{"蚯蚓结", "麋角解", "水泉动"},
+	{"雁北乡", "鹊始巢", "雉始雊"},
+	{"鸡始乳", "征鸟厉疾", "水泽腹坚"},
+	{"东风解冻", "蛰虫始振", "鱼陟负冰"},
+	{"獭祭鱼", "候雁北", "草木萌动"},
+	{"桃始华", "仓庚鸣", "鹰化为鸠"},
+}
+
+// phenologiesTraditional 七十二候中文繁体
+var phenologiesTraditional = [24][3]string{
+	{"玄鳥至", "雷乃發聲", "始電"},
+	{"桐始華", "田鼠化為鴽", "虹始見"},
+	{"萍始生", "鳴鳩拂其羽", "戴勝降于桑"},
+	{"螻蟈鳴", "蚯蚓出", "王瓜生"},
+	{"苦菜秀", "靡草死", "麥秋至"},
+	{"螳螂生", "鵙始鳴", "反舌無聲"},
+	{"鹿角解", "蜩始鳴", "半夏生"},
+	{"溫風至", "蟋蟀居壁", "鷹始摯"},
+	{"腐草為螢", "土潤溽暑", "大雨時行"},
+	{"涼風至", "白露降", "寒蟬鳴"},
+	{"鷹乃祭鳥", "天地始肅", "禾乃登"},
+	{"鴻雁來", "玄鳥歸", "群鳥養羞"},
+	{"雷始收聲", "蟄蟲坯戶", "水始涸"},
+	{"鴻雁來賓", "雀入大水為蛤", "菊有黃華"},
+	{"豺乃祭獸", "草木黃落", "蟄蟲咸俯"},
+	{"水始冰", "地始凍", "雉入大水為蜃"},
+	{"虹藏不見", "天氣上升地氣下降", "閉塞而成冬"},
+	{"鶡鴠不鳴", "虎始交", "荔挺出"},
+	{"蚯蚓結", "麋角解", "水泉動"},
+	{"雁北鄉", "鵲始巢", "雉始雊"},
+	{"雞始乳", "征鳥厲疾", "水澤腹堅"},
+	{"東風解凍", "蟄蟲始振", "魚陟負冰"},
+	{"獺祭魚", "候雁北", "草木萌動"},
+	{"桃始華", "倉庚鳴", "鷹化為鳩"},
+}
+
+// Phenology 返回该节气某一候的物候(候应)
+// 例: SolarTermEnum.TheBeginningOfSpring.Phenology(FirstPentad, true) -> 东风解冻
+func (st SolarTerm) Phenology(p Pentad, simplified bool) string {
+	if !st.IsValid() || !p.IsValid() {
+		return ""
+	}
+
+	if simplified {
+		return phenologies[st][p]
+	}
+	return phenologiesTraditional[st][p]
+}
+
+// Phenology 返回所处之候的物候
+func (p SolarTermPeriod) Phenology(simplified bool) string {
+	return p.SolarTerm.Phenology(p.Pentad, simplified)
+}
@@ -0,0 +1,55 @@
+package solar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSolarTermPhenology(t *testing.T) {
+	inputs := []struct {
+		SolarTerm
+		Pentad
+	}{
+		{SolarTermEnum.TheBeginningOfSpring, FirstPentad},
+		{SolarTermEnum.TheBeginningOfSpring, SecondPentad},
+		{SolarTermEnum.TheBeginningOfSpring, ThirdPentad},
+		{SolarTermEnum.TheSpringEquinox, FirstPentad},
+		{SolarTermEnum.LesserSnow, SecondPentad},
+		{SolarTermEnum.TheWakingOfInsects, ThirdPentad},
+		{SolarTermEnum.TheWakingOfInsects, 3},
+		{24, FirstPentad},
+	}
+	expect := []struct {
+		Simplified  string
+		Traditional string
+	}{
+		{"东风解冻", "東風解凍"},
+		{"蛰虫始振", "蟄蟲始振"},
+		{"鱼陟负冰", "魚陟負冰"},
+		{"玄鸟至", "玄鳥至"},
+		{"天气上升地气下降", "天氣上升地氣下降"},
+		{"鹰化为鸠", "鷹化為鳩"},
+		{"", ""},
+		{"", ""},
+	}
+
+	for idx, each := range inputs {
+		s := each.SolarTerm.Phenology(each.Pentad, true)
+		tr := each.SolarTerm.Phenology(each.Pentad, false)
+		if s != expect[idx].Simplified || tr != expect[idx].Traditional {
+			t.Fatalf("phenology of %d-%d should be %s/%s, got %s/%s",
+				each.SolarTerm,
+				each.Pentad,
+				expect[idx].Simplified,
+				expect[idx].Traditional,
+				s,
+				tr,
+			)
+		}
+	}
+
+	p := NewSolarTermPeriodFromTime(time.Date(2024, 4, 25, 0, 0, 0, 0, time.UTC))
+	if actual := p.Phenology(true); actual != "鸣鸠拂其羽" {
+		t.Fatalf("phenology of 谷雨次候 should be 鸣鸠拂其羽, got %s", actual)
+	}
+}
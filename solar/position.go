@@ -0,0 +1,44 @@
+package solar
+
+import (
+	"math"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// ApparentLongitude 返回t时刻太阳的地心视黄经
+// 基于VSOP87截断级数, 计入FK5修正, 章动与光行差, 在公元1000至3000年间误差在1角秒左右
+func ApparentLongitude(t time.Time) EclipticLongitude {
+	return EclipticLongitude(apparentLongitude(astro.JulianEphemerisDay(t)))
+}
+
+// apparentLongitude 返回儒略历书日jde时太阳的视黄经, 单位度
+func apparentLongitude(jde float64) float64 {
+	tau := (jde - astro.J2000) / 365250
+	t := tau * 10
+
+	lon, lat, r := geometricPosition(tau)
+	dpsi, _ := nutation(t)
+
+	// 光行差
+	aberration := -20.4898 / 3600 / r
+
+	return astro.NormalizeDegrees(lon + fk5Correction(lon, lat, t) + dpsi + aberration)
+}
+
+// geometricPosition 返回tau(自J2000.0起算的儒略千年数)时太阳的几何地心黄经, 黄纬(度)与日地距离(天文单位)
+func geometricPosition(tau float64) (float64, float64, float64) {
+	l := evaluateVSOP87(earthL, tau)
+	b := evaluateVSOP87(earthB, tau)
+	r := evaluateVSOP87(earthR, tau)
+
+	// 地心坐标下太阳的位置与日心坐标下地球的位置方向相反
+	return l*180/math.Pi + 180, -b * 180 / math.Pi, r
+}
+
+// fk5Correction 将VSOP87动力学黄道坐标转换到FK5系统时的黄经修正, 单位度
+func fk5Correction(lon, lat, t float64) float64 {
+	lp := (lon - 1.397*t - 0.00031*t*t) * math.Pi / 180
+	return (-0.09033 + 0.03916*(math.Cos(lp)+math.Sin(lp))*math.Tan(lat*math.Pi/180)) / 3600
+}
@@ -0,0 +1,36 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestApparentLongitude(t *testing.T) {
+	t.Run("test against Meeus example 25.b", func(t *testing.T) {
+		// 1992年10月13日0时(力学时), 视黄经199°54'21.818"
+		expect := 199 + 54.0/60 + 21.818/3600
+		actual := apparentLongitude(2448908.5)
+		if math.Abs(actual-expect)*3600 > 0.1 {
+			t.Fatalf("apparent longitude at JDE 2448908.5 should be %.6f, got %.6f", expect, actual)
+		}
+	})
+
+	t.Run("test at solar term instants", func(t *testing.T) {
+		inputs := []time.Time{
+			time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC),
+			time.Date(2024, 2, 4, 8, 27, 0, 0, time.UTC),
+			time.Date(2023, 12, 22, 3, 27, 0, 0, time.UTC),
+		}
+		expect := []EclipticLongitude{0, 315, 270}
+
+		for idx, each := range inputs {
+			actual := ApparentLongitude(each)
+			diff := math.Mod(float64(actual-expect[idx])+540, 360) - 180
+			// 输入时刻截断到分钟, 太阳每分钟约移动2.5角秒
+			if math.Abs(diff)*3600 > 5 {
+				t.Fatalf("apparent longitude at %s should be near %.0f, got %.6f", each, expect[idx], actual)
+			}
+		}
+	})
+}
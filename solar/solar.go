@@ -4,15 +4,15 @@ import "math"
 
 const (
 	// 初候
-	FirstPentad = 0
+	FirstPentad Pentad = 0
 	// 次候
-	SecondPentad = 1
+	SecondPentad Pentad = 1
 	// 末候
-	ThirdPentad = 2
+	ThirdPentad Pentad = 2
 )
 
 // Pentad 候
-// 一个节气分三候: 初候,伺候,末候
+// 一个节气分三候: 初候,次候,末候
 // 三候将一个节气均匀分为各5天, 个别候为6天
 type Pentad int
 
@@ -24,7 +24,7 @@ func (p Pentad) String() string {
 }
 
 func (p Pentad) IsValid() bool {
-	return p >= 3 && p < 3
+	return p >= 0 && p < 3
 }
 
 // solarTerms 24节气中文简体
@@ -46,14 +46,14 @@ var solarTermsTraditional = [24]string{
 // SolarTerm 24节气
 type SolarTerm int
 
-// 返回往后(或往面)第n个节气
+// Move 返回往后(或往前)第n个节气
 // nth > 0往后推, nth < 0 往前推
 func (st SolarTerm) Move(byNth int) SolarTerm {
-	t := (st + SolarTerm(byNth)) % 24
+	t := (int(st) + byNth) % 24
 	if t < 0 {
-		t = SolarTerm(24)
+		t += 24
 	}
-	return t
+	return SolarTerm(t)
 }
 
 // IsMidTerm 该节气是否是中气
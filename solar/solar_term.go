@@ -0,0 +1,43 @@
+package solar
+
+import (
+	"math"
+	"time"
+
+	"github.com/hsldymq/go-chinese-calendar/internal/astro"
+)
+
+// tropicalYear 回归年长度, 单位日
+const tropicalYear = 365.2422
+
+// Time 返回公历year年中该节气交节的时刻(UTC)
+// 即太阳视黄经到达该节气对应的15°整数倍的时刻
+// 例: solar.SolarTermEnum.TheBeginningOfSpring.Time(2024) -> 2024-02-04 08:27 UTC (北京时间16:27)
+func (st SolarTerm) Time(year int) time.Time {
+	if !st.IsValid() {
+		return time.Time{}
+	}
+
+	// 春分约在3月20日, 此后每个节气约间隔15.22日, 小寒至惊蛰落在公历年初
+	jde := astro.JulianDay(time.Date(year, 3, 20, 12, 0, 0, 0, time.UTC)) + float64(st)*tropicalYear/24
+	if st >= SolarTermEnum.LesserCold {
+		jde -= tropicalYear
+	}
+
+	return astro.TimeFromJulianEphemerisDay(solveLongitude(float64(st)*15, jde))
+}
+
+// solveLongitude 从儒略历书日jde开始迭代, 求太阳视黄经等于longitude(度)的时刻
+// jde与目标时刻的距离应在半年之内
+func solveLongitude(longitude, jde float64) float64 {
+	for i := 0; i < 10; i++ {
+		diff := longitude - apparentLongitude(jde)
+		diff -= math.Floor(diff/360+0.5) * 360
+		jde += diff * tropicalYear / 360
+		// 1e-7度约相当于0.01秒
+		if math.Abs(diff) < 1e-7 {
+			break
+		}
+	}
+	return jde
+}
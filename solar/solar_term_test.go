@@ -0,0 +1,133 @@
+package solar
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestSolarTerm(t *testing.T) {
+	t.Run("test Move method", func(t *testing.T) {
+		ns := []int{1, -1, 23, -23, 24, -24, 25, -25, -49}
+		expect := []SolarTerm{
+			SolarTermEnum.PureBrightness, SolarTermEnum.TheWakingOfInsects,
+			SolarTermEnum.TheWakingOfInsects, SolarTermEnum.PureBrightness,
+			SolarTermEnum.TheSpringEquinox, SolarTermEnum.TheSpringEquinox,
+			SolarTermEnum.PureBrightness, SolarTermEnum.TheWakingOfInsects,
+			SolarTermEnum.TheWakingOfInsects,
+		}
+
+		for idx, n := range ns {
+			actual := SolarTermEnum.TheSpringEquinox.Move(n)
+			if actual != expect[idx] {
+				t.Fatalf("move 春分 by %d should return %d, got %d", n, expect[idx], actual)
+			}
+		}
+	})
+
+	t.Run("test Time method", func(t *testing.T) {
+		// 数据来自紫金山天文台发布的节气时刻(北京时间)
+		beijing := time.FixedZone("UTC+8", 8*60*60)
+		inputs := []struct {
+			SolarTerm
+			Year int
+		}{
+			{SolarTermEnum.TheSpringEquinox, 2000},
+			{SolarTermEnum.TheSummerSolstice, 2020},
+			{SolarTermEnum.TheWinterSolstice, 2023},
+			{SolarTermEnum.TheBeginningOfSpring, 2024},
+			{SolarTermEnum.TheSpringEquinox, 2024},
+			{SolarTermEnum.TheBeginningOfSpring, 2025},
+		}
+		expect := []time.Time{
+			time.Date(2000, 3, 20, 15, 35, 0, 0, beijing),
+			time.Date(2020, 6, 21, 5, 43, 33, 0, beijing),
+			time.Date(2023, 12, 22, 11, 27, 9, 0, beijing),
+			time.Date(2024, 2, 4, 16, 26, 53, 0, beijing),
+			time.Date(2024, 3, 20, 11, 6, 21, 0, beijing),
+			time.Date(2025, 2, 3, 22, 10, 13, 0, beijing),
+		}
+
+		for idx, each := range inputs {
+			actual := each.SolarTerm.Time(each.Year)
+			diff := actual.Sub(expect[idx])
+			if diff < -time.Minute || diff > time.Minute {
+				t.Fatalf("%s of %d should begin at %s, got %s",
+					each.SolarTerm.String(true),
+					each.Year,
+					expect[idx],
+					actual.In(beijing),
+				)
+			}
+		}
+	})
+
+	t.Run("test Time method for every solar term", func(t *testing.T) {
+		for year := 1000; year <= 3000; year += 250 {
+			for st := SolarTerm(0); st < 24; st++ {
+				actual := st.Time(year)
+				if actual.Year() != year {
+					t.Fatalf("%s of %d should be in the same year, got %s", st.String(true), year, actual)
+				}
+				diff := math.Mod(float64(ApparentLongitude(actual))-float64(st)*15+540, 360) - 180
+				if math.Abs(diff)*3600 > 0.01 {
+					t.Fatalf("sun should reach %s at %s, got %.6f", st.String(true), actual, ApparentLongitude(actual))
+				}
+			}
+		}
+	})
+}
+
+func TestSolarTermIterator(t *testing.T) {
+	t.Run("test a whole year", func(t *testing.T) {
+		from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		expect := SolarTermEnum.LesserCold
+
+		count := 0
+		it := NewSolarTermIterator(from, to)
+		for it.Next() {
+			if it.SolarTerm() != expect {
+				t.Fatalf("the %dth solar term of 2024 should be %s, got %s", count, expect.String(true), it.SolarTerm().String(true))
+			}
+			if actual := expect.Time(2024); it.Time().Sub(actual) > time.Second || actual.Sub(it.Time()) > time.Second {
+				t.Fatalf("%s of 2024 should begin at %s, got %s", expect.String(true), actual, it.Time())
+			}
+			expect = expect.Move(1)
+			count++
+		}
+		if count != 24 {
+			t.Fatalf("there should be 24 solar terms in 2024, got %d", count)
+		}
+		if it.Next() {
+			t.Fatalf("iterator should stay exhausted")
+		}
+	})
+
+	t.Run("test range boundaries", func(t *testing.T) {
+		start := SolarTermEnum.TheBeginningOfSpring.Time(2024)
+		it := NewSolarTermIterator(start, start.Add(time.Hour))
+		if !it.Next() || it.SolarTerm() != SolarTermEnum.TheBeginningOfSpring {
+			t.Fatalf("iterator should include the solar term beginning at from")
+		}
+		if it.Next() {
+			t.Fatalf("iterator should exclude solar terms after to")
+		}
+	})
+
+	t.Run("test two hundred years", func(t *testing.T) {
+		from := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+		to := time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC)
+		count := 0
+		prev := from
+		for it := NewSolarTermIterator(from, to); it.Next(); count++ {
+			if !it.Time().After(prev) {
+				t.Fatalf("solar terms should be in chronological order, %s is not after %s", it.Time(), prev)
+			}
+			prev = it.Time()
+		}
+		if count != 200*24 {
+			t.Fatalf("there should be %d solar terms in 200 years, got %d", 200*24, count)
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package calendar
+
+import "strconv"
+
+// yearNumberingWords 纪年法名称中文简体
+var yearNumberingWords = [5]string{"民国", "黄帝纪元", "孔子纪元", "佛历", "主体"}
+
+// yearNumberingWordsTraditional 纪年法名称中文繁体
+var yearNumberingWordsTraditional = [5]string{"民國", "黃帝紀元", "孔子紀元", "佛曆", "主體"}
+
+// YearNumbering 纪年法
+type YearNumbering int
+
+// Year 返回公历year年在该纪年法下的年数
+// 民国纪年与主体纪年始于1912年, 之前的年份第二个返回值为false; 黄帝纪元与孔子纪元按农历年计, 此处视为与公历年相同
+// 例: YearNumberingEnum.Minguo.Year(2024) -> 113
+func (n YearNumbering) Year(year int) (int, bool) {
+	switch n {
+	case YearNumberingEnum.Minguo, YearNumberingEnum.Juche:
+		if year < 1912 {
+			return 0, false
+		}
+		return year - 1911, true
+	case YearNumberingEnum.HuangDi:
+		return positiveYear(year + 2698)
+	case YearNumberingEnum.Confucius:
+		return positiveYear(year + 551)
+	case YearNumberingEnum.Buddhist:
+		return positiveYear(year + 543)
+	}
+	return 0, false
+}
+
+// YearOfLunarDate 返回农历日期d在该纪年法下的年数
+// 黄帝纪元与孔子纪元以正月初一为岁首, 按农历年计; 民国, 佛历与主体纪年以公历1月1日为岁首, 按d所在的公历年计
+// 例: 农历2024年腊月廿一为公历2025年1月20日, 民国纪年为114年, 黄帝纪元仍为4722年
+func (n YearNumbering) YearOfLunarDate(d LunarDate) (int, bool) {
+	switch n {
+	case YearNumberingEnum.HuangDi, YearNumberingEnum.Confucius:
+		return n.Year(d.Year)
+	}
+
+	t, ok := d.Time()
+	if !ok {
+		return 0, false
+	}
+	return n.Year(t.Year())
+}
+
+// Format 返回公历year年在该纪年法下的中文写法, 如民国113年, 佛历2567年
+// 1912年之前的民国纪年写作民国前N年, 无法表示时返回空字符串
+func (n YearNumbering) Format(year int, simplified bool) string {
+	if n == YearNumberingEnum.Minguo && year < 1912 {
+		return n.String(simplified) + "前" + strconv.Itoa(1912-year) + "年"
+	}
+	y, ok := n.Year(year)
+	if !ok {
+		return ""
+	}
+	return n.String(simplified) + strconv.Itoa(y) + "年"
+}
+
+// FormatLunarDate 返回农历日期d所在年在该纪年法下的中文写法, 岁首的取法与YearOfLunarDate相同
+func (n YearNumbering) FormatLunarDate(d LunarDate, simplified bool) string {
+	switch n {
+	case YearNumberingEnum.HuangDi, YearNumberingEnum.Confucius:
+		return n.Format(d.Year, simplified)
+	}
+
+	t, ok := d.Time()
+	if !ok {
+		return ""
+	}
+	return n.Format(t.Year(), simplified)
+}
+
+// String 返回纪年法名称
+func (n YearNumbering) String(simplified bool) string {
+	if !n.IsValid() {
+		return ""
+	}
+	if simplified {
+		return yearNumberingWords[n]
+	}
+	return yearNumberingWordsTraditional[n]
+}
+
+func (n YearNumbering) IsValid() bool {
+	return n >= 0 && n < 5
+}
+
+// positiveYear 年数不小于1时有效
+func positiveYear(year int) (int, bool) {
+	return year, year >= 1
+}
+
+// YearNumberingEnum 纪年法枚举项
+var YearNumberingEnum = struct {
+	Minguo    YearNumbering // 民国纪年, 1912年为民国元年, 以公历1月1日为岁首
+	HuangDi   YearNumbering // 黄帝纪元, 采用辛亥革命时通行的算法, 1911年为4609年, 以正月初一为岁首
+	Confucius YearNumbering // 孔子纪元, 以孔子诞生的公元前551年为元年, 以正月初一为岁首
+	Buddhist  YearNumbering // 佛历, 采用泰国的算法, 公历年加543, 以公历1月1日为岁首
+	Juche     YearNumbering // 主体纪年, 1912年为主体元年, 以公历1月1日为岁首
+}{
+	Minguo:    0,
+	HuangDi:   1,
+	Confucius: 2,
+	Buddhist:  3,
+	Juche:     4,
+}
@@ -0,0 +1,79 @@
+package calendar
+
+import "testing"
+
+func TestYearNumbering(t *testing.T) {
+	t.Run("test Format method", func(t *testing.T) {
+		inputs := []struct {
+			YearNumbering
+			Year int
+		}{
+			{YearNumberingEnum.Minguo, 2024},
+			{YearNumberingEnum.Minguo, 1912},
+			{YearNumberingEnum.Minguo, 1911},
+			{YearNumberingEnum.Minguo, 1900},
+			{YearNumberingEnum.HuangDi, 1911},
+			{YearNumberingEnum.Confucius, 2024},
+			{YearNumberingEnum.Buddhist, 2024},
+			{YearNumberingEnum.Juche, 2024},
+			{YearNumberingEnum.Juche, 1911},
+			{YearNumbering(5), 2024},
+		}
+		expect := []struct {
+			Simplified  string
+			Traditional string
+		}{
+			{"民国113年", "民國113年"},
+			{"民国1年", "民國1年"},
+			{"民国前1年", "民國前1年"},
+			{"民国前12年", "民國前12年"},
+			{"黄帝纪元4609年", "黃帝紀元4609年"},
+			{"孔子纪元2575年", "孔子紀元2575年"},
+			{"佛历2567年", "佛曆2567年"},
+			{"主体113年", "主體113年"},
+			{"", ""},
+			{"", ""},
+		}
+
+		for idx, each := range inputs {
+			s, tr := each.Format(each.Year, true), each.Format(each.Year, false)
+			if s != expect[idx].Simplified || tr != expect[idx].Traditional {
+				t.Fatalf("year %d in numbering %d should be %s and %s, got %s and %s",
+					each.Year,
+					each.YearNumbering,
+					expect[idx].Simplified,
+					expect[idx].Traditional,
+					s,
+					tr,
+				)
+			}
+		}
+	})
+
+	t.Run("test YearOfLunarDate method", func(t *testing.T) {
+		// 农历2024年腊月廿一为公历2025年1月20日
+		d := LunarDate{Year: 2024, Month: 12, Day: 21}
+		inputs := []YearNumbering{
+			YearNumberingEnum.Minguo,
+			YearNumberingEnum.HuangDi,
+			YearNumberingEnum.Confucius,
+			YearNumberingEnum.Buddhist,
+			YearNumberingEnum.Juche,
+		}
+		expect := []int{114, 4722, 2575, 2568, 114}
+
+		for idx, each := range inputs {
+			actual, ok := each.YearOfLunarDate(d)
+			if !ok || actual != expect[idx] {
+				t.Fatalf("year of %+v in numbering %s should be %d, got %d", d, each.String(true), expect[idx], actual)
+			}
+		}
+
+		if _, ok := YearNumberingEnum.Minguo.YearOfLunarDate(LunarDate{Year: 2024, Month: 1, Day: 30}); ok {
+			t.Fatalf("year of an invalid lunar date should not be valid")
+		}
+		if actual := YearNumberingEnum.Minguo.FormatLunarDate(d, false); actual != "民國114年" {
+			t.Fatalf("year of %+v should be 民國114年, got %s", d, actual)
+		}
+	})
+}